@@ -209,6 +209,29 @@ func Convert_v1beta1_LogPipelineInput_To_v1alpha1_LogPipelineInput(in *telemetry
 	return nil
 }
 
+// Convert_v1alpha1_OTLPInput_To_v1beta1_LogPipelineOTLPInput converts the shared v1alpha1.OTLPInput to the log-specific v1beta1.LogPipelineOTLPInput.
+func Convert_v1alpha1_OTLPInput_To_v1beta1_LogPipelineOTLPInput(in *OTLPInput, out *telemetryv1beta1.LogPipelineOTLPInput, s apiconversion.Scope) error {
+	return Convert_v1alpha1_OTLPInput_To_v1beta1_OTLPInput(in, &out.OTLPInput, s)
+}
+
+// Convert_v1beta1_LogPipelineOTLPInput_To_v1alpha1_OTLPInput converts the log-specific v1beta1.LogPipelineOTLPInput to the shared v1alpha1.OTLPInput.
+// The MinSeverity field is intentionally not converted: it is a v1beta1-only feature not available in v1alpha1.
+func Convert_v1beta1_LogPipelineOTLPInput_To_v1alpha1_OTLPInput(in *telemetryv1beta1.LogPipelineOTLPInput, out *OTLPInput, s apiconversion.Scope) error {
+	return Convert_v1beta1_OTLPInput_To_v1alpha1_OTLPInput(&in.OTLPInput, out, s)
+}
+
+// Convert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec converts v1beta1.LogPipelineSpec to v1alpha1.LogPipelineSpec.
+// The AdditionalOutputs field is intentionally not converted: it is a v1beta1-only feature not available in v1alpha1.
+func Convert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(in *telemetryv1beta1.LogPipelineSpec, out *LogPipelineSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(in, out, s)
+}
+
+// Convert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput converts v1beta1.LogPipelineOutput to v1alpha1.LogPipelineOutput.
+// The Kafka, Loki, Elasticsearch, and Failover fields are intentionally not converted: they are v1beta1-only features not available in v1alpha1.
+func Convert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput(in *telemetryv1beta1.LogPipelineOutput, out *LogPipelineOutput, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput(in, out, s)
+}
+
 // marshalData stores the source object as json data in the destination object annotations map.
 // It ignores the status of the source object, since there is no need to store the current status
 func marshalData(src metav1.Object, dst metav1.Object) error {
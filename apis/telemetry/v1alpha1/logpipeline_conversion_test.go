@@ -174,11 +174,13 @@ var v1beta1LogPipeline = &telemetryv1beta1.LogPipeline{
 				FluentBitDropLabels:      new(true),
 				KeepOriginalBody:         new(true),
 			},
-			OTLP: &telemetryv1beta1.OTLPInput{
-				Enabled: new(false),
-				Namespaces: &telemetryv1beta1.NamespaceSelector{
-					Include: []string{"include", "include2"},
-					Exclude: []string{"exclude", "exclude2"},
+			OTLP: &telemetryv1beta1.LogPipelineOTLPInput{
+				OTLPInput: telemetryv1beta1.OTLPInput{
+					Enabled: new(false),
+					Namespaces: &telemetryv1beta1.NamespaceSelector{
+						Include: []string{"include", "include2"},
+						Exclude: []string{"exclude", "exclude2"},
+					},
 				},
 			},
 		},
@@ -310,11 +312,13 @@ func TestLogPipelineConvertTo(t *testing.T) {
 			expected: &telemetryv1beta1.LogPipeline{
 				Spec: telemetryv1beta1.LogPipelineSpec{
 					Input: telemetryv1beta1.LogPipelineInput{
-						OTLP: &telemetryv1beta1.OTLPInput{
-							Enabled: new(true),
-							Namespaces: &telemetryv1beta1.NamespaceSelector{
-								Include: []string{"valid-ns", "another-valid-ns"},
-								Exclude: []string{"valid-excluded", "another-valid-excluded"},
+						OTLP: &telemetryv1beta1.LogPipelineOTLPInput{
+							OTLPInput: telemetryv1beta1.OTLPInput{
+								Enabled: new(true),
+								Namespaces: &telemetryv1beta1.NamespaceSelector{
+									Include: []string{"valid-ns", "another-valid-ns"},
+									Exclude: []string{"valid-excluded", "another-valid-excluded"},
+								},
 							},
 						},
 					},
@@ -53,3 +53,27 @@ func Convert_v1alpha1_MetricPipelineRuntimeInput_To_v1beta1_MetricPipelineRuntim
 
 	return nil
 }
+
+// Convert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput converts v1beta1.MetricPipelineInput to v1alpha1.MetricPipelineInput.
+// The Host field is intentionally not converted: it is a v1beta1-only feature not available in v1alpha1.
+func Convert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(in *telemetryv1beta1.MetricPipelineInput, out *MetricPipelineInput, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(in, out, s)
+}
+
+// Convert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput converts v1beta1.MetricPipelinePrometheusInput to v1alpha1.MetricPipelinePrometheusInput.
+// The Monitors field is intentionally not converted: it is a v1beta1-only feature not available in v1alpha1.
+func Convert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput(in *telemetryv1beta1.MetricPipelinePrometheusInput, out *MetricPipelinePrometheusInput, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput(in, out, s)
+}
+
+// Convert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec converts v1beta1.MetricPipelineSpec to v1alpha1.MetricPipelineSpec.
+// The AdditionalOutputs, Redaction, and Aggregations fields are intentionally not converted: they are v1beta1-only features not available in v1alpha1.
+func Convert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(in *telemetryv1beta1.MetricPipelineSpec, out *MetricPipelineSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(in, out, s)
+}
+
+// Convert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput converts v1beta1.MetricPipelineOutput to v1alpha1.MetricPipelineOutput.
+// The Kafka, PrometheusRemoteWrite, Prometheus, and Failover fields are intentionally not converted: they are v1beta1-only features not available in v1alpha1.
+func Convert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(in *telemetryv1beta1.MetricPipelineOutput, out *MetricPipelineOutput, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(in, out, s)
+}
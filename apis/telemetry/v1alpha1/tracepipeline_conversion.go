@@ -0,0 +1,28 @@
+package v1alpha1
+
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+// Converts between v1alpha1 and v1beta1 TracePipeline CRDs.
+// Fields that were introduced in v1beta1 only are dropped when converting to v1alpha1.
+
+// Convert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec converts v1beta1.TracePipelineSpec to v1alpha1.TracePipelineSpec.
+// The Sampling, AdditionalOutputs, and Metrics fields are intentionally not converted: they are v1beta1-only features not available in v1alpha1.
+func Convert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(in *telemetryv1beta1.TracePipelineSpec, out *TracePipelineSpec, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(in, out, s)
+}
+
+// Convert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput converts v1beta1.TracePipelineOutput to v1alpha1.TracePipelineOutput.
+// The Kafka and Failover fields are intentionally not converted: they are v1beta1-only features not available in v1alpha1.
+func Convert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(in *telemetryv1beta1.TracePipelineOutput, out *TracePipelineOutput, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(in, out, s)
+}
+
+// Convert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus converts v1beta1.TracePipelineStatus to v1alpha1.TracePipelineStatus.
+// The Sampling field is intentionally not converted: it is a v1beta1-only feature not available in v1alpha1.
+func Convert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus(in *telemetryv1beta1.TracePipelineStatus, out *TracePipelineStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus(in, out, s)
+}
@@ -161,21 +161,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.LogPipelineOutput)(nil), (*LogPipelineOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput(a.(*v1beta1.LogPipelineOutput), b.(*LogPipelineOutput), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*LogPipelineSpec)(nil), (*v1beta1.LogPipelineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LogPipelineSpec_To_v1beta1_LogPipelineSpec(a.(*LogPipelineSpec), b.(*v1beta1.LogPipelineSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.LogPipelineSpec)(nil), (*LogPipelineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(a.(*v1beta1.LogPipelineSpec), b.(*LogPipelineSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*LogPipelineStatus)(nil), (*v1beta1.LogPipelineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LogPipelineStatus_To_v1beta1_LogPipelineStatus(a.(*LogPipelineStatus), b.(*v1beta1.LogPipelineStatus), scope)
 	}); err != nil {
@@ -201,11 +191,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineInput)(nil), (*MetricPipelineInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(a.(*v1beta1.MetricPipelineInput), b.(*MetricPipelineInput), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*MetricPipelineIstioInput)(nil), (*v1beta1.MetricPipelineIstioInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MetricPipelineIstioInput_To_v1beta1_MetricPipelineIstioInput(a.(*MetricPipelineIstioInput), b.(*v1beta1.MetricPipelineIstioInput), scope)
 	}); err != nil {
@@ -251,21 +236,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineOutput)(nil), (*MetricPipelineOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(a.(*v1beta1.MetricPipelineOutput), b.(*MetricPipelineOutput), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*MetricPipelinePrometheusInput)(nil), (*v1beta1.MetricPipelinePrometheusInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MetricPipelinePrometheusInput_To_v1beta1_MetricPipelinePrometheusInput(a.(*MetricPipelinePrometheusInput), b.(*v1beta1.MetricPipelinePrometheusInput), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelinePrometheusInput)(nil), (*MetricPipelinePrometheusInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput(a.(*v1beta1.MetricPipelinePrometheusInput), b.(*MetricPipelinePrometheusInput), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineRuntimeInput)(nil), (*MetricPipelineRuntimeInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MetricPipelineRuntimeInput_To_v1alpha1_MetricPipelineRuntimeInput(a.(*v1beta1.MetricPipelineRuntimeInput), b.(*MetricPipelineRuntimeInput), scope)
 	}); err != nil {
@@ -296,11 +271,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.MetricPipelineSpec)(nil), (*MetricPipelineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(a.(*v1beta1.MetricPipelineSpec), b.(*MetricPipelineSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*MetricPipelineStatus)(nil), (*v1beta1.MetricPipelineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MetricPipelineStatus_To_v1beta1_MetricPipelineStatus(a.(*MetricPipelineStatus), b.(*v1beta1.MetricPipelineStatus), scope)
 	}); err != nil {
@@ -371,31 +341,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.TracePipelineOutput)(nil), (*TracePipelineOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(a.(*v1beta1.TracePipelineOutput), b.(*TracePipelineOutput), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*TracePipelineSpec)(nil), (*v1beta1.TracePipelineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TracePipelineSpec_To_v1beta1_TracePipelineSpec(a.(*TracePipelineSpec), b.(*v1beta1.TracePipelineSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.TracePipelineSpec)(nil), (*TracePipelineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(a.(*v1beta1.TracePipelineSpec), b.(*TracePipelineSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*TracePipelineStatus)(nil), (*v1beta1.TracePipelineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TracePipelineStatus_To_v1beta1_TracePipelineStatus(a.(*TracePipelineStatus), b.(*v1beta1.TracePipelineStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.TracePipelineStatus)(nil), (*TracePipelineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus(a.(*v1beta1.TracePipelineStatus), b.(*TracePipelineStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*TransformSpec)(nil), (*v1beta1.TransformSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TransformSpec_To_v1beta1_TransformSpec(a.(*TransformSpec), b.(*v1beta1.TransformSpec), scope)
 	}); err != nil {
@@ -441,6 +396,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*OTLPInput)(nil), (*v1beta1.LogPipelineOTLPInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OTLPInput_To_v1beta1_LogPipelineOTLPInput(a.(*OTLPInput), b.(*v1beta1.LogPipelineOTLPInput), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*OTLPInput)(nil), (*v1beta1.OTLPInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_OTLPInput_To_v1beta1_OTLPInput(a.(*OTLPInput), b.(*v1beta1.OTLPInput), scope)
 	}); err != nil {
@@ -451,6 +411,41 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.LogPipelineOTLPInput)(nil), (*OTLPInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LogPipelineOTLPInput_To_v1alpha1_OTLPInput(a.(*v1beta1.LogPipelineOTLPInput), b.(*OTLPInput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.LogPipelineOutput)(nil), (*LogPipelineOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput(a.(*v1beta1.LogPipelineOutput), b.(*LogPipelineOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.LogPipelineSpec)(nil), (*LogPipelineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(a.(*v1beta1.LogPipelineSpec), b.(*LogPipelineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.MetricPipelineInput)(nil), (*MetricPipelineInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(a.(*v1beta1.MetricPipelineInput), b.(*MetricPipelineInput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.MetricPipelineOutput)(nil), (*MetricPipelineOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(a.(*v1beta1.MetricPipelineOutput), b.(*MetricPipelineOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.MetricPipelinePrometheusInput)(nil), (*MetricPipelinePrometheusInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput(a.(*v1beta1.MetricPipelinePrometheusInput), b.(*MetricPipelinePrometheusInput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.MetricPipelineSpec)(nil), (*MetricPipelineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(a.(*v1beta1.MetricPipelineSpec), b.(*MetricPipelineSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta1.OTLPInput)(nil), (*OTLPInput)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OTLPInput_To_v1alpha1_OTLPInput(a.(*v1beta1.OTLPInput), b.(*OTLPInput), scope)
 	}); err != nil {
@@ -466,6 +461,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.TracePipelineOutput)(nil), (*TracePipelineOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(a.(*v1beta1.TracePipelineOutput), b.(*TracePipelineOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.TracePipelineSpec)(nil), (*TracePipelineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(a.(*v1beta1.TracePipelineSpec), b.(*TracePipelineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.TracePipelineStatus)(nil), (*TracePipelineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus(a.(*v1beta1.TracePipelineStatus), b.(*TracePipelineStatus), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -759,8 +769,8 @@ func autoConvert_v1alpha1_LogPipelineInput_To_v1beta1_LogPipelineInput(in *LogPi
 	// WARNING: in.Application requires manual conversion: does not exist in peer-type
 	if in.OTLP != nil {
 		in, out := &in.OTLP, &out.OTLP
-		*out = new(v1beta1.OTLPInput)
-		if err := Convert_v1alpha1_OTLPInput_To_v1beta1_OTLPInput(*in, *out, s); err != nil {
+		*out = new(v1beta1.LogPipelineOTLPInput)
+		if err := Convert_v1alpha1_OTLPInput_To_v1beta1_LogPipelineOTLPInput(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -774,12 +784,13 @@ func autoConvert_v1beta1_LogPipelineInput_To_v1alpha1_LogPipelineInput(in *v1bet
 	if in.OTLP != nil {
 		in, out := &in.OTLP, &out.OTLP
 		*out = new(OTLPInput)
-		if err := Convert_v1beta1_OTLPInput_To_v1alpha1_OTLPInput(*in, *out, s); err != nil {
+		if err := Convert_v1beta1_LogPipelineOTLPInput_To_v1alpha1_OTLPInput(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
 		out.OTLP = nil
 	}
+	// WARNING: in.Events requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -873,14 +884,13 @@ func autoConvert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput(in *v1b
 	} else {
 		out.OTLP = nil
 	}
+	// WARNING: in.Kafka requires manual conversion: does not exist in peer-type
+	// WARNING: in.Loki requires manual conversion: does not exist in peer-type
+	// WARNING: in.Elasticsearch requires manual conversion: does not exist in peer-type
+	// WARNING: in.Failover requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput is an autogenerated conversion function.
-func Convert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput(in *v1beta1.LogPipelineOutput, out *LogPipelineOutput, s conversion.Scope) error {
-	return autoConvert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput(in, out, s)
-}
-
 func autoConvert_v1alpha1_LogPipelineSpec_To_v1beta1_LogPipelineSpec(in *LogPipelineSpec, out *v1beta1.LogPipelineSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_LogPipelineInput_To_v1beta1_LogPipelineInput(&in.Input, &out.Input, s); err != nil {
 		return err
@@ -909,18 +919,16 @@ func autoConvert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(in *v1beta1
 	if err := Convert_v1beta1_LogPipelineOutput_To_v1alpha1_LogPipelineOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	// WARNING: in.AdditionalOutputs requires manual conversion: does not exist in peer-type
 	out.FluentBitFiles = *(*[]FluentBitFile)(unsafe.Pointer(&in.FluentBitFiles))
 	out.FluentBitVariables = *(*[]FluentBitVariable)(unsafe.Pointer(&in.FluentBitVariables))
+	// WARNING: in.Redaction requires manual conversion: does not exist in peer-type
 	out.Transforms = *(*[]TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]FilterSpec)(unsafe.Pointer(&in.Filters))
+	// WARNING: in.Deduplicate requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec is an autogenerated conversion function.
-func Convert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(in *v1beta1.LogPipelineSpec, out *LogPipelineSpec, s conversion.Scope) error {
-	return autoConvert_v1beta1_LogPipelineSpec_To_v1alpha1_LogPipelineSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_LogPipelineStatus_To_v1beta1_LogPipelineStatus(in *LogPipelineStatus, out *v1beta1.LogPipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.UnsupportedMode = (*bool)(unsafe.Pointer(in.UnsupportedMode))
@@ -976,7 +984,15 @@ func Convert_v1beta1_MetricPipeline_To_v1alpha1_MetricPipeline(in *v1beta1.Metri
 }
 
 func autoConvert_v1alpha1_MetricPipelineInput_To_v1beta1_MetricPipelineInput(in *MetricPipelineInput, out *v1beta1.MetricPipelineInput, s conversion.Scope) error {
-	out.Prometheus = (*v1beta1.MetricPipelinePrometheusInput)(unsafe.Pointer(in.Prometheus))
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(v1beta1.MetricPipelinePrometheusInput)
+		if err := Convert_v1alpha1_MetricPipelinePrometheusInput_To_v1beta1_MetricPipelinePrometheusInput(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
 	if in.Runtime != nil {
 		in, out := &in.Runtime, &out.Runtime
 		*out = new(v1beta1.MetricPipelineRuntimeInput)
@@ -1005,7 +1021,15 @@ func Convert_v1alpha1_MetricPipelineInput_To_v1beta1_MetricPipelineInput(in *Met
 }
 
 func autoConvert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(in *v1beta1.MetricPipelineInput, out *MetricPipelineInput, s conversion.Scope) error {
-	out.Prometheus = (*MetricPipelinePrometheusInput)(unsafe.Pointer(in.Prometheus))
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(MetricPipelinePrometheusInput)
+		if err := Convert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
 	if in.Runtime != nil {
 		in, out := &in.Runtime, &out.Runtime
 		*out = new(MetricPipelineRuntimeInput)
@@ -1015,6 +1039,7 @@ func autoConvert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(in
 	} else {
 		out.Runtime = nil
 	}
+	// WARNING: in.Host requires manual conversion: does not exist in peer-type
 	out.Istio = (*MetricPipelineIstioInput)(unsafe.Pointer(in.Istio))
 	if in.OTLP != nil {
 		in, out := &in.OTLP, &out.OTLP
@@ -1028,11 +1053,6 @@ func autoConvert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(in
 	return nil
 }
 
-// Convert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput is an autogenerated conversion function.
-func Convert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(in *v1beta1.MetricPipelineInput, out *MetricPipelineInput, s conversion.Scope) error {
-	return autoConvert_v1beta1_MetricPipelineInput_To_v1alpha1_MetricPipelineInput(in, out, s)
-}
-
 func autoConvert_v1alpha1_MetricPipelineIstioInput_To_v1beta1_MetricPipelineIstioInput(in *MetricPipelineIstioInput, out *v1beta1.MetricPipelineIstioInput, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Namespaces = (*v1beta1.NamespaceSelector)(unsafe.Pointer(in.Namespaces))
@@ -1175,14 +1195,13 @@ func autoConvert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(i
 	} else {
 		out.OTLP = nil
 	}
+	// WARNING: in.Kafka requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrometheusRemoteWrite requires manual conversion: does not exist in peer-type
+	// WARNING: in.Prometheus requires manual conversion: does not exist in peer-type
+	// WARNING: in.Failover requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput is an autogenerated conversion function.
-func Convert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(in *v1beta1.MetricPipelineOutput, out *MetricPipelineOutput, s conversion.Scope) error {
-	return autoConvert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(in, out, s)
-}
-
 func autoConvert_v1alpha1_MetricPipelinePrometheusInput_To_v1beta1_MetricPipelinePrometheusInput(in *MetricPipelinePrometheusInput, out *v1beta1.MetricPipelinePrometheusInput, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Namespaces = (*v1beta1.NamespaceSelector)(unsafe.Pointer(in.Namespaces))
@@ -1199,14 +1218,10 @@ func autoConvert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelin
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Namespaces = (*NamespaceSelector)(unsafe.Pointer(in.Namespaces))
 	out.DiagnosticMetrics = (*MetricPipelineIstioInputDiagnosticMetrics)(unsafe.Pointer(in.DiagnosticMetrics))
+	// WARNING: in.Monitors requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput is an autogenerated conversion function.
-func Convert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput(in *v1beta1.MetricPipelinePrometheusInput, out *MetricPipelinePrometheusInput, s conversion.Scope) error {
-	return autoConvert_v1beta1_MetricPipelinePrometheusInput_To_v1alpha1_MetricPipelinePrometheusInput(in, out, s)
-}
-
 func autoConvert_v1alpha1_MetricPipelineRuntimeInput_To_v1beta1_MetricPipelineRuntimeInput(in *MetricPipelineRuntimeInput, out *v1beta1.MetricPipelineRuntimeInput, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Namespaces = (*v1beta1.NamespaceSelector)(unsafe.Pointer(in.Namespaces))
@@ -1306,16 +1321,14 @@ func autoConvert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(in *v
 	if err := Convert_v1beta1_MetricPipelineOutput_To_v1alpha1_MetricPipelineOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	// WARNING: in.AdditionalOutputs requires manual conversion: does not exist in peer-type
+	// WARNING: in.Redaction requires manual conversion: does not exist in peer-type
 	out.Transforms = *(*[]TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]FilterSpec)(unsafe.Pointer(&in.Filters))
+	// WARNING: in.Aggregations requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec is an autogenerated conversion function.
-func Convert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(in *v1beta1.MetricPipelineSpec, out *MetricPipelineSpec, s conversion.Scope) error {
-	return autoConvert_v1beta1_MetricPipelineSpec_To_v1alpha1_MetricPipelineSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_MetricPipelineStatus_To_v1beta1_MetricPipelineStatus(in *MetricPipelineStatus, out *v1beta1.MetricPipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
@@ -1437,6 +1450,7 @@ func autoConvert_v1beta1_OTLPOutput_To_v1alpha1_OTLPOutput(in *v1beta1.OTLPOutpu
 	out.Headers = *(*[]Header)(unsafe.Pointer(&in.Headers))
 	out.TLS = (*OTLPTLS)(unsafe.Pointer(in.TLS))
 	// WARNING: in.Compression requires manual conversion: does not exist in peer-type
+	// WARNING: in.PersistentQueue requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1566,14 +1580,11 @@ func autoConvert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(in
 	} else {
 		out.OTLP = nil
 	}
+	// WARNING: in.Kafka requires manual conversion: does not exist in peer-type
+	// WARNING: in.Failover requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput is an autogenerated conversion function.
-func Convert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(in *v1beta1.TracePipelineOutput, out *TracePipelineOutput, s conversion.Scope) error {
-	return autoConvert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(in, out, s)
-}
-
 func autoConvert_v1alpha1_TracePipelineSpec_To_v1beta1_TracePipelineSpec(in *TracePipelineSpec, out *v1beta1.TracePipelineSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_TracePipelineOutput_To_v1beta1_TracePipelineOutput(&in.Output, &out.Output, s); err != nil {
 		return err
@@ -1592,16 +1603,15 @@ func autoConvert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(in *v1b
 	if err := Convert_v1beta1_TracePipelineOutput_To_v1alpha1_TracePipelineOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	// WARNING: in.AdditionalOutputs requires manual conversion: does not exist in peer-type
+	// WARNING: in.Redaction requires manual conversion: does not exist in peer-type
 	out.Transforms = *(*[]TransformSpec)(unsafe.Pointer(&in.Transforms))
 	out.Filters = *(*[]FilterSpec)(unsafe.Pointer(&in.Filters))
+	// WARNING: in.Sampling requires manual conversion: does not exist in peer-type
+	// WARNING: in.Metrics requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec is an autogenerated conversion function.
-func Convert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(in *v1beta1.TracePipelineSpec, out *TracePipelineSpec, s conversion.Scope) error {
-	return autoConvert_v1beta1_TracePipelineSpec_To_v1alpha1_TracePipelineSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_TracePipelineStatus_To_v1beta1_TracePipelineStatus(in *TracePipelineStatus, out *v1beta1.TracePipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
@@ -1614,14 +1624,10 @@ func Convert_v1alpha1_TracePipelineStatus_To_v1beta1_TracePipelineStatus(in *Tra
 
 func autoConvert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus(in *v1beta1.TracePipelineStatus, out *TracePipelineStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.Sampling requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus is an autogenerated conversion function.
-func Convert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus(in *v1beta1.TracePipelineStatus, out *TracePipelineStatus, s conversion.Scope) error {
-	return autoConvert_v1beta1_TracePipelineStatus_To_v1alpha1_TracePipelineStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_TransformSpec_To_v1beta1_TransformSpec(in *TransformSpec, out *v1beta1.TransformSpec, s conversion.Scope) error {
 	out.Conditions = *(*[]string)(unsafe.Pointer(&in.Conditions))
 	out.Statements = *(*[]string)(unsafe.Pointer(&in.Statements))
@@ -54,14 +54,21 @@ type LogPipeline struct {
 }
 
 // LogPipelineSpec defines the desired state of LogPipeline
-// +kubebuilder:validation:XValidation:rule="!(has(self.output.otlp) && has(self.input.runtime.dropLabels))", message="input.runtime.dropLabels is not supported with otlp output"
-// +kubebuilder:validation:XValidation:rule="!(has(self.output.otlp) && has(self.input.runtime.keepAnnotations))", message="input.runtime.keepAnnotations is not supported with otlp output"
-// +kubebuilder:validation:XValidation:rule="!(has(self.output.otlp) && has(self.filters))", message="filters are not supported with otlp output"
-// +kubebuilder:validation:XValidation:rule="!(has(self.output.otlp) && has(self.files))", message="files not supported with otlp output"
-// +kubebuilder:validation:XValidation:rule="!(has(self.output.otlp) && has(self.variables))", message="variables not supported with otlp output"
-// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.transform))", message="transform is only supported with otlp output"
-// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.filter))", message="filter is only supported with otlp output"
-// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.input.otlp))", message="otlp input is only supported with otlp output"
+// +kubebuilder:validation:XValidation:rule="!((has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch)) && has(self.input.runtime.dropLabels))", message="input.runtime.dropLabels is not supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="!((has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch)) && has(self.input.runtime.keepAnnotations))", message="input.runtime.keepAnnotations is not supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="!((has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch)) && has(self.filters))", message="filters are not supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="!((has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch)) && has(self.files))", message="files not supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="!((has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch)) && has(self.variables))", message="variables not supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.transform))", message="transform is only supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.filter))", message="filter is only supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.input.otlp))", message="otlp input is only supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || !(has(self.additionalOutputs))", message="additionalOutputs are only supported with otlp output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.input.runtime.multiline))", message="input.runtime.multiline is only supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.input.runtime.pods))", message="input.runtime.pods is only supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.input.runtime.parser))", message="input.runtime.parser is only supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.input.events))", message="events input is only supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.deduplicate))", message="deduplicate is only supported with otlp, kafka, loki or elasticsearch output"
+// +kubebuilder:validation:XValidation:rule="has(self.output.otlp) || has(self.output.kafka) || has(self.output.loki) || has(self.output.elasticsearch) || !(has(self.redaction))", message="redaction is only supported with otlp, kafka, loki or elasticsearch output"
 type LogPipelineSpec struct {
 	// Input configures additional inputs for log collection.
 	// +kubebuilder:validation:Optional
@@ -73,6 +80,11 @@ type LogPipelineSpec struct {
 	// Output configures the backend to which logs are sent. You must specify exactly one output per pipeline.
 	// +kubebuilder:validation:Required
 	Output LogPipelineOutput `json:"output"`
+	// AdditionalOutputs configures further backends to which logs are sent in addition to the backend configured in `output`. All outputs share the same processing of the pipeline, but each output has its own exporter and sending queue.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=5
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name))", message="Output names must be unique"
+	AdditionalOutputs []NamedOTLPOutput `json:"additionalOutputs,omitempty"`
 	// Deprecated: The field is based on the Fluent Bit-based technology stack. Use the OpenTelemetry-based stack instead, see https://kyma-project.io/external-content/telemetry-manager/docs/user/integrate-otlp-backend/migration-to-otlp-logs.html.
 	// FluentBitFiles is a list of content snippets that are mounted as files in the Fluent Bit configuration, which can be linked in the `custom` filters and a `custom` output. Only available when using an output of type `http` and `custom`.
 	// +kubebuilder:validation:Optional
@@ -81,12 +93,26 @@ type LogPipelineSpec struct {
 	// FluentBitVariables is a list of mappings from Kubernetes Secret keys to environment variables. Mapped keys are mounted as environment variables, so that they are available as [FluentBitVariables](https://docs.fluentbit.io/manual/administration/configuring-fluent-bit/classic-mode/variables) in the `custom` filters and a `custom` output. Only available when using an output of type `http` and `custom`.
 	// +kubebuilder:validation:Optional
 	FluentBitVariables []FluentBitVariable `json:"variables,omitempty"`
+	// Redaction masks sensitive data, like credit card numbers or email addresses, before the data leaves the cluster. Only available when using an OpenTelemetry-based output like `otlp`. Redaction is applied before the transformations defined in `transform`.
+	// +kubebuilder:validation:Optional
+	Redaction *RedactionSpec `json:"redaction,omitempty"`
 	// Transforms specify a list of transformations to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Transforms []TransformSpec `json:"transform,omitempty"`
 	// Filters specifies a list of filters to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Filters []FilterSpec `json:"filter,omitempty"`
+	// Deduplicate collapses identical application logs of the same container within a time window into a single log record with a `log_count` attribute, before the Log Agent sends them. To enable deduplication with the default settings, use an empty struct notation. Only available when using an OpenTelemetry-based output like `otlp`.
+	// +kubebuilder:validation:Optional
+	Deduplicate *LogPipelineDeduplicate `json:"deduplicate,omitempty"`
+}
+
+// LogPipelineDeduplicate configures the deduplication of application logs in the Log Agent.
+type LogPipelineDeduplicate struct {
+	// Interval defines the time window in which identical logs are collapsed. At the end of each window, one log record is sent for each set of identical logs. The default is `10s`. The value must be at least `1s`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="deduplicate.interval must be at least 1s"
+	Interval *metav1.Duration `json:"interval,omitempty"`
 }
 
 // LogPipelineInput configures additional inputs for log collection.
@@ -96,7 +122,42 @@ type LogPipelineInput struct {
 	Runtime *LogPipelineRuntimeInput `json:"runtime,omitempty"`
 	// OTLP input configures the push endpoint to receive logs from an OTLP source.
 	// +kubebuilder:validation:Optional
-	OTLP *OTLPInput `json:"otlp,omitempty"`
+	OTLP *LogPipelineOTLPInput `json:"otlp,omitempty"`
+	// Events input configures the collection of Kubernetes events. Only available when using an OpenTelemetry-based output like `otlp`.
+	// +kubebuilder:validation:Optional
+	Events *LogPipelineEventsInput `json:"events,omitempty"`
+}
+
+// LogPipelineOTLPInput defines the collection of push-based logs that use the OpenTelemetry protocol.
+type LogPipelineOTLPInput struct {
+	OTLPInput `json:",inline"`
+	// MinSeverity drops push-based OTLP logs with a severity lower than the given level. Logs without a severity are always kept.
+	// +kubebuilder:validation:Optional
+	MinSeverity *LogPipelineMinSeverity `json:"minSeverity,omitempty"`
+}
+
+type EventType string
+
+const (
+	EventTypeNormal  EventType = "Normal"
+	EventTypeWarning EventType = "Warning"
+)
+
+// LogPipelineEventsInput configures the collection of Kubernetes events, which are emitted as log records.
+type LogPipelineEventsInput struct {
+	// Enabled specifies if the 'events' input is enabled. If enabled, Kubernetes events are collected. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Namespaces describes whether events from specific namespaces are selected. The options are mutually exclusive. By default, all namespaces except the system namespaces are enabled. To enable all namespaces including system namespaces, use an empty struct notation.
+	// +kubebuilder:validation:Optional
+	Namespaces *NamespaceSelector `json:"namespaces,omitempty"`
+	// Types specifies the types of the events to collect. The options are `Normal` and `Warning`. By default, events of all types are collected.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Enum=Normal;Warning
+	Types []EventType `json:"types,omitempty"`
+	// Reasons specifies the reasons of the events to collect, like `OOMKilling` or `Evicted`. By default, events with any reason are collected.
+	// +kubebuilder:validation:Optional
+	Reasons []string `json:"reasons,omitempty"`
 }
 
 // LogPipelineRuntimeInput configures the log collection from application containers stdout/stderr by tailing the log files of the underlying container runtime.
@@ -110,6 +171,9 @@ type LogPipelineRuntimeInput struct {
 	// Containers describes whether application logs from specific containers are selected. The options are mutually exclusive.
 	// +kubebuilder:validation:Optional
 	Containers *LogPipelineContainerSelector `json:"containers,omitempty"`
+	// Pods describes whether application logs from specific pods are selected, based on the labels and annotations of the pods. Pods are selected across all namespaces selected by `namespaces`. Only available when using an OpenTelemetry-based output like `otlp`.
+	// +kubebuilder:validation:Optional
+	Pods *LogPipelinePodSelector `json:"pods,omitempty"`
 	// Deprecated: The field is based on the Fluent Bit-based technology stack. Use the OpenTelemetry-based stack instead, see https://kyma-project.io/external-content/telemetry-manager/docs/user/integrate-otlp-backend/migration-to-otlp-logs.html.
 	// FluentBitKeepAnnotations defines whether to keep all Kubernetes annotations. The default is `false`.  Only available when using an output of type `http` and `custom`.
 	// +kubebuilder:validation:Optional
@@ -121,6 +185,99 @@ type LogPipelineRuntimeInput struct {
 	// KeepOriginalBody retains the original log data if the log data is in JSON and it is successfully parsed. If set to `false`, the original log data is removed from the log record. The default is `true`.
 	// +kubebuilder:validation:Optional
 	KeepOriginalBody *bool `json:"keepOriginalBody,omitempty"`
+	// Multiline defines rules to assemble logs spanning multiple lines, like stack traces, into a single log record. For each container, the first rule that selects the container applies. Only available when using an OpenTelemetry-based output like `otlp`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	Multiline []LogPipelineMultiline `json:"multiline,omitempty"`
+	// Parser defines an additional format in which the application logs are parsed. Logs in JSON format are always parsed. Only available when using an OpenTelemetry-based output like `otlp`.
+	// +kubebuilder:validation:Optional
+	Parser *LogPipelineParser `json:"parser,omitempty"`
+	// MinSeverity drops application logs with a severity lower than the given level. Logs without a severity are always kept.
+	// +kubebuilder:validation:Optional
+	MinSeverity *LogPipelineMinSeverity `json:"minSeverity,omitempty"`
+}
+
+type LogSeverity string
+
+const (
+	LogSeverityTrace LogSeverity = "TRACE"
+	LogSeverityDebug LogSeverity = "DEBUG"
+	LogSeverityInfo  LogSeverity = "INFO"
+	LogSeverityWarn  LogSeverity = "WARN"
+	LogSeverityError LogSeverity = "ERROR"
+	LogSeverityFatal LogSeverity = "FATAL"
+)
+
+// LogPipelineMinSeverity defines the minimum severity of the logs that are collected.
+type LogPipelineMinSeverity struct {
+	// Level defines the lowest severity of the logs that are kept. The options are `TRACE`, `DEBUG`, `INFO`, `WARN`, `ERROR`, and `FATAL`.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=TRACE;DEBUG;INFO;WARN;ERROR;FATAL
+	Level LogSeverity `json:"level"`
+	// Overrides defines different levels for logs from specific namespaces. If multiple overrides match a namespace, the first one applies.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	Overrides []LogPipelineMinSeverityOverride `json:"overrides,omitempty"`
+}
+
+// LogPipelineMinSeverityOverride defines the minimum severity of the logs from specific namespaces.
+type LogPipelineMinSeverityOverride struct {
+	// Namespaces selects the namespaces to which the level applies. You must define either an include list or an exclude list.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="has(self.include) || has(self.exclude)", message="One of 'include' or 'exclude' must be defined"
+	Namespaces NamespaceSelector `json:"namespaces"`
+	// Level defines the lowest severity of the logs from the selected namespaces that are kept.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=TRACE;DEBUG;INFO;WARN;ERROR;FATAL
+	Level LogSeverity `json:"level"`
+}
+
+type ParserFormat string
+
+const (
+	ParserFormatLogfmt   ParserFormat = "logfmt"
+	ParserFormatCombined ParserFormat = "combined"
+	ParserFormatKlog     ParserFormat = "klog"
+	ParserFormatRegex    ParserFormat = "regex"
+)
+
+// LogPipelineParser defines how application logs that are not in JSON format are parsed into structured log records.
+// +kubebuilder:validation:XValidation:rule="(self.format == 'regex') == has(self.regex)",message="'regex' must be defined if and only if 'format' is 'regex'"
+type LogPipelineParser struct {
+	// Format selects the format of the logs. The options are `logfmt`, `combined` for the Apache and nginx combined log format, `klog` for the Kubernetes log format, and `regex` for a custom regular expression.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=logfmt;combined;klog;regex
+	Format ParserFormat `json:"format"`
+	// Regex is a regular expression (RE2 syntax) with named capture groups, which parses the logs if `format` is `regex`. Each named capture group becomes a log attribute.
+	// +kubebuilder:validation:Optional
+	Regex string `json:"regex,omitempty"`
+}
+
+type MultilinePreset string
+
+const (
+	MultilinePresetJava   MultilinePreset = "java"
+	MultilinePresetPython MultilinePreset = "python"
+	MultilinePresetGo     MultilinePreset = "go"
+	MultilinePresetDotNet MultilinePreset = "dotnet"
+)
+
+// LogPipelineMultiline defines how the lines of a multiline log are assembled into a single log record, either with a built-in preset or with custom patterns.
+// +kubebuilder:validation:XValidation:rule="has(self.preset) != (has(self.startPattern) || has(self.continuationPattern))",message="Exactly one of 'preset' or 'startPattern'/'continuationPattern' must be defined"
+type LogPipelineMultiline struct {
+	// Containers specifies the names of the containers whose logs are assembled with this rule. If not set, the rule applies to all containers.
+	// +kubebuilder:validation:Optional
+	Containers []string `json:"containers,omitempty"`
+	// Preset selects built-in patterns for the stack traces of a language runtime. The options are `java`, `python`, `go`, and `dotnet`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=java;python;go;dotnet
+	Preset MultilinePreset `json:"preset,omitempty"`
+	// StartPattern is a regular expression (RE2 syntax) matching the first line of a log. Each line matching the pattern starts a new log record.
+	// +kubebuilder:validation:Optional
+	StartPattern string `json:"startPattern,omitempty"`
+	// ContinuationPattern is a regular expression (RE2 syntax) matching the continuation lines of a log. Each line matching the pattern is appended to the previous log record. If `startPattern` is also defined, lines matching neither pattern are kept as separate log records.
+	// +kubebuilder:validation:Optional
+	ContinuationPattern string `json:"continuationPattern,omitempty"`
 }
 
 // LogPipelineContainerSelector describes whether application logs from specific containers are selected. The options are mutually exclusive.
@@ -134,9 +291,21 @@ type LogPipelineContainerSelector struct {
 	Exclude []string `json:"exclude,omitempty"`
 }
 
+// LogPipelinePodSelector describes whether application logs from specific pods are selected. If both labels and annotations are defined, a pod must match both.
+// +kubebuilder:validation:XValidation:rule="has(self.labels) || has(self.annotations)",message="At least one of 'labels' or 'annotations' must be defined"
+type LogPipelinePodSelector struct {
+	// Labels selects the pods by their labels, using the Kubernetes label selector syntax with `matchLabels` and `matchExpressions`.
+	// +kubebuilder:validation:Optional
+	Labels *metav1.LabelSelector `json:"labels,omitempty"`
+	// Annotations selects the pods carrying all of the given annotations with the given values.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 // LogPipelineOutput configures the backend to which logs are sent. You must specify exactly one output per pipeline.
-// +kubebuilder:validation:XValidation:rule="has(self.otlp) == has(oldSelf.otlp)", message="Switching to or away from OTLP output is not supported. Please re-create the LogPipeline instead"
-// +kubebuilder:validation:XValidation:rule="(has(self.custom) == true ? 1 : 0) + (has(self.http) == true ? 1 : 0) + (has(self.otlp) == true ? 1 : 0) == 1",message="Exactly one output out of 'custom', 'http' or 'otlp' must be defined"
+// +kubebuilder:validation:XValidation:rule="(has(self.otlp) || has(self.kafka) || has(self.loki) || has(self.elasticsearch)) == (has(oldSelf.otlp) || has(oldSelf.kafka) || has(oldSelf.loki) || has(oldSelf.elasticsearch))", message="Switching to or away from OTLP output is not supported. Please re-create the LogPipeline instead"
+// +kubebuilder:validation:XValidation:rule="(has(self.custom) == true ? 1 : 0) + (has(self.http) == true ? 1 : 0) + (has(self.otlp) == true ? 1 : 0) + (has(self.kafka) == true ? 1 : 0) + (has(self.loki) == true ? 1 : 0) + (has(self.elasticsearch) == true ? 1 : 0) == 1",message="Exactly one output out of 'custom', 'http', 'otlp', 'kafka', 'loki' or 'elasticsearch' must be defined"
+// +kubebuilder:validation:XValidation:rule="has(self.otlp) || !has(self.failover)",message="Failover output is only supported with otlp output"
 type LogPipelineOutput struct {
 	// Deprecated: The field is based on the Fluent Bit-based technology stack. Use the OpenTelemetry-based stack instead, see https://kyma-project.io/external-content/telemetry-manager/docs/user/integrate-otlp-backend/migration-to-otlp-logs.html.
 	// FluentBitCustom defines a custom output in the [Fluent Bit syntax](https://docs.fluentbit.io/manual/pipeline/outputs) where you want to push the logs. If you use a `custom` output, you put the LogPipeline in unsupported mode. Only available when using an output of type `http` and `custom`.
@@ -149,6 +318,57 @@ type LogPipelineOutput struct {
 	// OTLP defines an output using the OpenTelemetry protocol.
 	// +kubebuilder:validation:Optional
 	OTLP *OTLPOutput `json:"otlp,omitempty"`
+	// Kafka defines an output that publishes logs to a Kafka topic. Like the `otlp` output, it is based on the OpenTelemetry-based technology stack.
+	// +kubebuilder:validation:Optional
+	Kafka *KafkaOutput `json:"kafka,omitempty"`
+	// Loki defines an output that pushes logs to Grafana Loki. Unlike the `http` and `custom` outputs, it is based on the OpenTelemetry-based technology stack. The output offers no mapping of resource attributes to Loki labels: Loki's native OTLP endpoint decides which resource attributes become index labels based on the `otlp_config` limits of the Loki instance.
+	// +kubebuilder:validation:Optional
+	Loki *LokiOutput `json:"loki,omitempty"`
+	// Elasticsearch defines an output that indexes logs in Elasticsearch or OpenSearch. Unlike the `http` and `custom` outputs, it is based on the OpenTelemetry-based technology stack.
+	// +kubebuilder:validation:Optional
+	Elasticsearch *ElasticsearchOutput `json:"elasticsearch,omitempty"`
+	// Failover defines a secondary backend using the OpenTelemetry protocol. While the backend of the `otlp` output is failing, data is sent to the failover backend instead. Once the primary backend recovers, data is sent to it again.
+	// +kubebuilder:validation:Optional
+	Failover *OTLPOutput `json:"failover,omitempty"`
+}
+
+// LokiOutput defines an output that pushes logs to the native OTLP endpoint of Grafana Loki (available since Loki 3.0).
+// Loki stores the resource attributes of a log either as index labels or as structured metadata. By default, Loki uses the attributes that identify the workload as index labels, for example, `service.name`, `k8s.namespace.name`, and `k8s.pod.name`.
+type LokiOutput struct {
+	// Endpoint defines the base URL of Loki, for example, `http://loki-gateway.loki:80`. Logs are pushed to the `/otlp/v1/logs` path of the endpoint.
+	// +kubebuilder:validation:Required
+	Endpoint ValueType `json:"endpoint"`
+	// Authentication defines authentication options for Loki.
+	// +kubebuilder:validation:Optional
+	Authentication *AuthenticationOptions `json:"authentication,omitempty"`
+	// Headers defines custom headers to be added to outgoing HTTP requests, for example, the `X-Scope-OrgID` tenant header.
+	// +kubebuilder:validation:Optional
+	Headers []Header `json:"headers,omitempty"`
+	// TLS defines TLS options for Loki.
+	// +kubebuilder:validation:Optional
+	TLS *OutputTLS `json:"tls,omitempty"`
+}
+
+// ElasticsearchOutput defines an output that indexes logs in Elasticsearch or OpenSearch using the bulk API.
+type ElasticsearchOutput struct {
+	// Endpoint defines the URL of the Elasticsearch cluster, for example, `https://elasticsearch.example.com:9200`.
+	// +kubebuilder:validation:Required
+	Endpoint ValueType `json:"endpoint"`
+	// Authentication defines authentication options for Elasticsearch.
+	// +kubebuilder:validation:Optional
+	Authentication *AuthenticationOptions `json:"authentication,omitempty"`
+	// Headers defines custom headers to be added to outgoing HTTP requests, for example, an `Authorization` header with an API key.
+	// +kubebuilder:validation:Optional
+	Headers []Header `json:"headers,omitempty"`
+	// TLS defines TLS options for Elasticsearch.
+	// +kubebuilder:validation:Optional
+	TLS *OutputTLS `json:"tls,omitempty"`
+	// Index defines the index or data stream to which logs are written. If not set, the default data stream of the exporter (`logs-generic.otel-default`) is used.
+	// +kubebuilder:validation:Optional
+	Index string `json:"index,omitempty"`
+	// IndexFromResourceAttribute defines a resource attribute whose value selects the index of a log, for example, `k8s.namespace.name`. If `index` is set, logs are written to the index `<index>-<attribute value>`, otherwise to the index `<attribute value>`. Logs without the resource attribute are written to the default index.
+	// +kubebuilder:validation:Optional
+	IndexFromResourceAttribute string `json:"indexFromResourceAttribute,omitempty"`
 }
 
 // FluentBitHTTPOutput configures an HTTP-based output compatible with the Fluent Bit HTTP output plugin.
@@ -64,6 +64,15 @@ type MetricPipelineSpec struct {
 	// +kubebuilder:validation:Required
 	Output MetricPipelineOutput `json:"output"`
 
+	// AdditionalOutputs configures further backends to which metrics are sent in addition to the backend configured in `output`. All outputs share the same processing of the pipeline, but each output has its own exporter and sending queue.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=5
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name))", message="Output names must be unique"
+	AdditionalOutputs []NamedOTLPOutput `json:"additionalOutputs,omitempty"`
+
+	// Redaction masks sensitive data, like credit card numbers or email addresses, before the data leaves the cluster. Redaction is applied before the transformations defined in `transform`.
+	// +kubebuilder:validation:Optional
+	Redaction *RedactionSpec `json:"redaction,omitempty"`
 	// Transforms specify a list of transformations to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Transforms []TransformSpec `json:"transform,omitempty"`
@@ -71,6 +80,49 @@ type MetricPipelineSpec struct {
 	// Filter specifies a list of filters to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Filters []FilterSpec `json:"filter,omitempty"`
+
+	// Aggregations specify a list of rules to pre-aggregate metrics before they are sent to the backend, for example, to sum up a metric across a high-cardinality attribute. The aggregations are applied after the transformations and filters.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	Aggregations []MetricAggregation `json:"aggregations,omitempty"`
+}
+
+type MetricAggregationType string
+
+const (
+	MetricAggregationTypeSum    MetricAggregationType = "Sum"
+	MetricAggregationTypeAvg    MetricAggregationType = "Avg"
+	MetricAggregationTypeMin    MetricAggregationType = "Min"
+	MetricAggregationTypeMax    MetricAggregationType = "Max"
+	MetricAggregationTypeMedian MetricAggregationType = "Median"
+	MetricAggregationTypeCount  MetricAggregationType = "Count"
+)
+
+// MetricAggregation defines how the data points of the matching metrics are aggregated.
+// +kubebuilder:validation:XValidation:rule="has(self.metricNames) != has(self.metricNamePattern)", message="Exactly one of metricNames or metricNamePattern must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.keepLabels) != has(self.dropLabels)", message="Exactly one of keepLabels or dropLabels must be set"
+type MetricAggregation struct {
+	// MetricNames specifies the names of the metrics that are aggregated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	MetricNames []string `json:"metricNames,omitempty"`
+	// MetricNamePattern specifies a regular expression (RE2 syntax) that matches the names of the metrics that are aggregated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	MetricNamePattern string `json:"metricNamePattern,omitempty"`
+	// KeepLabels specifies the labels that are kept. Labels are the attributes of the data points and of their resources, like `k8s.pod.name`. All other labels are removed, and the data points with the same remaining labels are aggregated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	KeepLabels []string `json:"keepLabels,omitempty"`
+	// DropLabels specifies the labels that are removed. Labels are the attributes of the data points and of their resources, like `k8s.pod.name`. The data points with the same remaining labels are aggregated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	DropLabels []string `json:"dropLabels,omitempty"`
+	// Type specifies the function that aggregates the values of the data points. Histograms support only `Sum`. The default is `Sum`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Sum;Avg;Min;Max;Median;Count
+	// +kubebuilder:default=Sum
+	Type MetricAggregationType `json:"type,omitempty"`
 }
 
 // MetricPipelineInput configures additional inputs for metric collection.
@@ -81,6 +133,9 @@ type MetricPipelineInput struct {
 	// Runtime input configures collection of Kubernetes runtime metrics.
 	// +kubebuilder:validation:Optional
 	Runtime *MetricPipelineRuntimeInput `json:"runtime,omitempty"`
+	// Host input configures collection of metrics from the operating systems of the Nodes, like filesystem usage, disk I/O, network traffic per interface, and load average.
+	// +kubebuilder:validation:Optional
+	Host *MetricPipelineHostInput `json:"host,omitempty"`
 	// Istio input configures collection of Istio metrics from applications running in the Istio service mesh.
 	// +kubebuilder:validation:Optional
 	Istio *MetricPipelineIstioInput `json:"istio,omitempty"`
@@ -100,6 +155,16 @@ type MetricPipelinePrometheusInput struct {
 	// DiagnosticMetrics configures collection of additional diagnostic metrics. The default is `false`.
 	// +kubebuilder:validation:Optional
 	DiagnosticMetrics *MetricPipelineIstioInputDiagnosticMetrics `json:"diagnosticMetrics,omitempty"`
+	// Monitors configures the discovery of scrape targets from Prometheus Operator ServiceMonitor and PodMonitor resources. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Monitors *MetricPipelinePrometheusInputMonitors `json:"monitors,omitempty"`
+}
+
+// MetricPipelinePrometheusInputMonitors configures the discovery of scrape targets from Prometheus Operator ServiceMonitor and PodMonitor resources.
+type MetricPipelinePrometheusInputMonitors struct {
+	// Enabled specifies if ServiceMonitor and PodMonitor resources (`monitoring.coreos.com/v1`) are used to discover scrape targets in addition to the `prometheus.io/scrape` annotations. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // MetricPipelineRuntimeInput configures collection of Kubernetes runtime metrics.
@@ -155,6 +220,49 @@ type MetricPipelineRuntimeInputResource struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+// MetricPipelineHostInput configures collection of metrics from the operating systems of the Nodes.
+type MetricPipelineHostInput struct {
+	// Enabled specifies if the 'host' input is enabled. If enabled, the metrics of the operating system are collected on every Node. The default is `false`.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Scrapers configures the groups of host metrics that are collected.
+	// +kubebuilder:validation:Optional
+	Scrapers *MetricPipelineHostInputScrapers `json:"scrapers,omitempty"`
+	// ExcludeMountPoints specifies regular expressions (RE2 syntax) matching the mount points for which no filesystem metrics are collected. Mount points of virtual and container filesystems are always excluded.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	ExcludeMountPoints []string `json:"excludeMountPoints,omitempty"`
+}
+
+// MetricPipelineHostInputScrapers configures the groups of host metrics that are collected.
+type MetricPipelineHostInputScrapers struct {
+	// CPU configures collection of the CPU time per CPU and state.
+	// +kubebuilder:validation:Optional
+	CPU *MetricPipelineHostInputScraper `json:"cpu,omitempty"`
+	// Memory configures collection of the memory usage per state.
+	// +kubebuilder:validation:Optional
+	Memory *MetricPipelineHostInputScraper `json:"memory,omitempty"`
+	// Disk configures collection of the disk I/O per device.
+	// +kubebuilder:validation:Optional
+	Disk *MetricPipelineHostInputScraper `json:"disk,omitempty"`
+	// Filesystem configures collection of the filesystem and inode usage per mount point.
+	// +kubebuilder:validation:Optional
+	Filesystem *MetricPipelineHostInputScraper `json:"filesystem,omitempty"`
+	// Network configures collection of the network traffic, errors, and dropped packets per network interface.
+	// +kubebuilder:validation:Optional
+	Network *MetricPipelineHostInputScraper `json:"network,omitempty"`
+	// Load configures collection of the load average over 1, 5, and 15 minutes.
+	// +kubebuilder:validation:Optional
+	Load *MetricPipelineHostInputScraper `json:"load,omitempty"`
+}
+
+// MetricPipelineHostInputScraper configures the collection of a group of host metrics.
+type MetricPipelineHostInputScraper struct {
+	// Enabled specifies that the metrics of the group are collected. The default is `true`.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
 // MetricPipelineIstioInput defines the Istio scraping section.
 type MetricPipelineIstioInput struct {
 	// Enabled specifies if the 'istio' input is enabled. If enabled, istio-proxy metrics are scraped from Pods that have the istio-proxy sidecar injected. The default is `false`.
@@ -179,10 +287,24 @@ type MetricPipelineIstioInputDiagnosticMetrics struct {
 }
 
 // MetricPipelineOutput defines the output configuration section.
+// +kubebuilder:validation:XValidation:rule="[has(self.otlp), has(self.kafka), has(self.prometheusRemoteWrite), has(self.prometheus)].filter(x, x).size() == 1",message="Exactly one output out of 'otlp', 'kafka', 'prometheusRemoteWrite' or 'prometheus' must be defined"
+// +kubebuilder:validation:XValidation:rule="has(self.otlp) || !has(self.failover)",message="Failover output is only supported with otlp output"
 type MetricPipelineOutput struct {
 	// MetricPipeline OTLP output defines a metric pipeline output using the OpenTelemetry protocol.
-	// +kubebuilder:validation:Required
-	OTLP *MetricPipelineOTLPOutput `json:"otlp"`
+	// +kubebuilder:validation:Optional
+	OTLP *MetricPipelineOTLPOutput `json:"otlp,omitempty"`
+	// Kafka defines an output that publishes metrics to a Kafka topic.
+	// +kubebuilder:validation:Optional
+	Kafka *KafkaOutput `json:"kafka,omitempty"`
+	// PrometheusRemoteWrite defines an output that sends metrics to a backend using the Prometheus remote-write protocol.
+	// +kubebuilder:validation:Optional
+	PrometheusRemoteWrite *PrometheusRemoteWriteOutput `json:"prometheusRemoteWrite,omitempty"`
+	// Prometheus defines an output that exposes metrics on a `/metrics` endpoint of the OTLP Gateway, which is scraped by a Prometheus server using the pull-based Prometheus protocol.
+	// +kubebuilder:validation:Optional
+	Prometheus *PrometheusExpositionOutput `json:"prometheus,omitempty"`
+	// Failover defines a secondary backend using the OpenTelemetry protocol. While the backend of the `otlp` output is failing, data is sent to the failover backend instead. Once the primary backend recovers, data is sent to it again.
+	// +kubebuilder:validation:Optional
+	Failover *OTLPOutput `json:"failover,omitempty"`
 }
 
 type MetricPipelineOTLPOutput struct {
@@ -195,6 +317,36 @@ type MetricPipelineOTLPOutput struct {
 	Temporality *TemporalityType `json:"temporality,omitempty"`
 }
 
+// PrometheusRemoteWriteOutput defines an output that sends metrics to a backend using the Prometheus remote-write protocol, such as Mimir, Thanos, or Cortex.
+// +kubebuilder:validation:XValidation:rule="has(self.endpoint.value) || has(self.endpoint.valueFrom)",message="'endpoint' must have 'value' or 'valueFrom' set"
+type PrometheusRemoteWriteOutput struct {
+	// Endpoint defines the URL of the remote-write endpoint, for example, `https://mimir.example.com/api/v1/push`.
+	// +kubebuilder:validation:Required
+	Endpoint ValueType `json:"endpoint"`
+	// Authentication defines authentication options for the remote-write endpoint.
+	// +kubebuilder:validation:Optional
+	Authentication *AuthenticationOptions `json:"authentication,omitempty"`
+	// Headers defines custom headers to be added to outgoing HTTP requests, for example, the `X-Scope-OrgID` tenant header of Mimir.
+	// +kubebuilder:validation:Optional
+	Headers []Header `json:"headers,omitempty"`
+	// TLS defines TLS options for the remote-write endpoint.
+	// +kubebuilder:validation:Optional
+	TLS *OutputTLS `json:"tls,omitempty"`
+	// ResourceAttributesToLabels specifies whether all resource attributes are added as labels to every exported time series. If disabled, resource attributes are only available as labels of the `target_info` metric. The default is `false`.
+	// +kubebuilder:validation:Optional
+	ResourceAttributesToLabels *bool `json:"resourceAttributesToLabels,omitempty"`
+}
+
+// PrometheusExpositionOutput defines an output that exposes metrics for scraping. Metrics with delta temporality are converted to cumulative temporality.
+type PrometheusExpositionOutput struct {
+	// Expiration defines how long a metric that doesn't receive new data points is still exposed. The default is `5m`. If multiple pipelines use the `prometheus` output, the longest expiration applies. The value must be greater than 0.
+	// +kubebuilder:validation:Optional
+	Expiration *metav1.Duration `json:"expiration,omitempty"`
+	// Staleness defines after which time without new data points a delta metric stream is considered stale. The accumulated state of a stale stream is dropped, so that the stream starts from zero again once it receives new data points. The default is `5m`. The value must be greater than 0.
+	// +kubebuilder:validation:Optional
+	Staleness *metav1.Duration `json:"staleness,omitempty"`
+}
+
 // MetricPipelineStatus defines the observed state of MetricPipeline.
 type MetricPipelineStatus struct {
 	// An array of conditions describing the status of the pipeline.
@@ -78,6 +78,29 @@ type OTLPOutput struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=none;gzip;snappy;zstd
 	Compression OTLPCompressionEncoding `json:"compression,omitempty"`
+	// PersistentQueue defines a disk-backed sending queue for the OTLP output. If enabled, data that cannot be sent to the backend yet is buffered on the node's disk instead of in memory, so that it survives a restart of the collector.
+	// +kubebuilder:validation:Optional
+	PersistentQueue *PersistentQueue `json:"persistentQueue,omitempty"`
+}
+
+// PersistentQueue defines a sending queue that buffers data on disk.
+type PersistentQueue struct {
+	// Enabled specifies whether the sending queue of the output is persisted on disk. Default is `false`.
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// NamedOTLPOutput defines an additional named backend to which telemetry data is sent using the OpenTelemetry protocol.
+type NamedOTLPOutput struct {
+	// Name identifies the output within the pipeline. It must be unique within the pipeline and is used in the status conditions of the output.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self != 'failover'",message="The output name 'failover' is reserved"
+	Name string `json:"name"`
+	// OTLP defines an output using the OpenTelemetry protocol.
+	// +kubebuilder:validation:Required
+	OTLP *OTLPOutput `json:"otlp"`
 }
 
 // AuthenticationOptions OTLP output authentication options
@@ -141,6 +164,77 @@ type Header struct {
 	Prefix string `json:"prefix,omitempty"`
 }
 
+type KafkaEncoding string
+
+const (
+	KafkaEncodingOTLPProto KafkaEncoding = "otlp_proto"
+	KafkaEncodingOTLPJSON  KafkaEncoding = "otlp_json"
+)
+
+type KafkaPartitionKey string
+
+const (
+	KafkaPartitionByTraceID            KafkaPartitionKey = "traceID"
+	KafkaPartitionByResourceAttributes KafkaPartitionKey = "resourceAttributes"
+)
+
+type KafkaSASLMechanism string
+
+const (
+	KafkaSASLMechanismPlain       KafkaSASLMechanism = "PLAIN"
+	KafkaSASLMechanismSCRAMSHA256 KafkaSASLMechanism = "SCRAM-SHA-256"
+	KafkaSASLMechanismSCRAMSHA512 KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+// KafkaOutput defines an output that publishes telemetry data to a topic of a Kafka cluster.
+type KafkaOutput struct {
+	// Brokers defines the addresses (`<host>:<port>`) of the Kafka brokers that are used to connect to the cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Brokers []string `json:"brokers"`
+	// Topic defines the Kafka topic to which the telemetry data is published.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Topic string `json:"topic"`
+	// Encoding defines the encoding of the published messages (`otlp_proto` or `otlp_json`). Default is `otlp_proto`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=otlp_proto;otlp_json
+	Encoding KafkaEncoding `json:"encoding,omitempty"`
+	// PartitionBy defines how messages are assigned to the partitions of the topic. With `traceID`, all spans or logs of a trace are published to the same partition (TracePipeline and LogPipeline only). With `resourceAttributes`, all metrics or logs of a resource are published to the same partition (MetricPipeline and LogPipeline only). If not set, messages are distributed across all partitions.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=traceID;resourceAttributes
+	PartitionBy KafkaPartitionKey `json:"partitionBy,omitempty"`
+	// Authentication defines authentication options for the Kafka output.
+	// +kubebuilder:validation:Optional
+	Authentication *KafkaAuthenticationOptions `json:"authentication,omitempty"`
+	// TLS defines TLS options for the Kafka output. If not set, the connection to the brokers is not encrypted.
+	// +kubebuilder:validation:Optional
+	TLS *OutputTLS `json:"tls,omitempty"`
+}
+
+// KafkaAuthenticationOptions contains authentication options for the Kafka output.
+type KafkaAuthenticationOptions struct {
+	// SASL activates SASL authentication.
+	// +kubebuilder:validation:Optional
+	SASL *KafkaSASLOptions `json:"sasl,omitempty"`
+}
+
+// KafkaSASLOptions contains options for SASL authentication.
+// +kubebuilder:validation:XValidation:rule="has(self.user.value) || has(self.user.valueFrom)",message="'user' must have 'value' or 'valueFrom' set"
+// +kubebuilder:validation:XValidation:rule="has(self.password.value) || has(self.password.valueFrom)",message="'password' must have 'value' or 'valueFrom' set"
+type KafkaSASLOptions struct {
+	// Mechanism defines the SASL mechanism (`PLAIN`, `SCRAM-SHA-256`, or `SCRAM-SHA-512`). Default is `SCRAM-SHA-512`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=PLAIN;SCRAM-SHA-256;SCRAM-SHA-512
+	Mechanism KafkaSASLMechanism `json:"mechanism,omitempty"`
+	// User contains the SASL username or a Secret reference.
+	// +kubebuilder:validation:Required
+	User ValueType `json:"user"`
+	// Password contains the SASL password or a Secret reference.
+	// +kubebuilder:validation:Required
+	Password ValueType `json:"password"`
+}
+
 // OutputTLS defines TLS options for an output.
 // +kubebuilder:validation:XValidation:rule="has(self.cert) == has(self.key)", message="Can define either both 'cert' and 'key', or neither"
 type OutputTLS struct {
@@ -186,6 +280,34 @@ type NamespaceSelector struct {
 	Exclude []string `json:"exclude,omitempty"`
 }
 
+type RedactionDetector string
+
+const (
+	RedactionDetectorCreditCard RedactionDetector = "CreditCard"
+	RedactionDetectorEmail      RedactionDetector = "Email"
+	RedactionDetectorJWT        RedactionDetector = "JWT"
+	RedactionDetectorIBAN       RedactionDetector = "IBAN"
+)
+
+// RedactionSpec defines the masking of sensitive data before the telemetry data leaves the cluster. Values matching a detector or a custom pattern are replaced with `****` in all attributes, and in the body of logs.
+// +kubebuilder:validation:XValidation:rule="has(self.detectors) || has(self.patterns)", message="One of 'detectors' or 'patterns' must be defined"
+type RedactionSpec struct {
+	// Detectors selects built-in detectors of sensitive data. The options are `CreditCard` for payment card numbers, `Email` for email addresses, `JWT` for JSON Web Tokens, and `IBAN` for international bank account numbers.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Enum=CreditCard;Email;JWT;IBAN
+	// +listType=set
+	Detectors []RedactionDetector `json:"detectors,omitempty"`
+	// Patterns specifies custom regular expressions (RE2 syntax) matching sensitive data.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:items:MinLength=1
+	Patterns []string `json:"patterns,omitempty"`
+	// AllowedKeys specifies the keys of attributes whose values are never masked.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:MinLength=1
+	AllowedKeys []string `json:"allowedKeys,omitempty"`
+}
+
 // TransformSpec defines a transformation to apply to telemetry data.
 type TransformSpec struct {
 	// Conditions specify a list of multiple where clauses, which will be processed as global conditions for the accompanying set of statements. The conditions are ORed together, which means only one condition needs to evaluate to true in order for the statements (including their individual where clauses) to be executed.
@@ -51,6 +51,16 @@ type TracePipelineSpec struct {
 	// +kubebuilder:validation:Required
 	Output TracePipelineOutput `json:"output"`
 
+	// AdditionalOutputs configures further backends to which traces are sent in addition to the backend configured in `output`. All outputs share the same processing of the pipeline, but each output has its own exporter and sending queue.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=5
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name))", message="Output names must be unique"
+	AdditionalOutputs []NamedOTLPOutput `json:"additionalOutputs,omitempty"`
+
+	// Redaction masks sensitive data, like credit card numbers or email addresses, before the data leaves the cluster. Redaction is applied before the transformations defined in `transform`.
+	// +kubebuilder:validation:Optional
+	Redaction *RedactionSpec `json:"redaction,omitempty"`
+
 	// Transforms specify a list of transformations to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Transforms []TransformSpec `json:"transform,omitempty"`
@@ -58,13 +68,183 @@ type TracePipelineSpec struct {
 	// Filter specifies a list of filters to apply to telemetry data.
 	// +kubebuilder:validation:Optional
 	Filters []FilterSpec `json:"filter,omitempty"`
+
+	// Sampling configures head-based and tail-based sampling of traces.
+	// +kubebuilder:validation:Optional
+	Sampling *TracePipelineSampling `json:"sampling,omitempty"`
+
+	// Metrics configures metrics that are derived from the spans of the pipeline and sent to a MetricPipeline.
+	// +kubebuilder:validation:Optional
+	Metrics *TracePipelineMetrics `json:"metrics,omitempty"`
+}
+
+// TracePipelineMetrics defines metrics that are derived from the spans of a TracePipeline, such as request rate, error rate, and duration (RED metrics).
+// +kubebuilder:validation:XValidation:rule="has(self.spanMetrics) || has(self.serviceGraph)", message="At least one of 'spanMetrics' or 'serviceGraph' must be defined"
+type TracePipelineMetrics struct {
+	// MetricPipeline defines the name of the MetricPipeline that receives the derived metrics. The metrics pass the transforms and filters of the MetricPipeline before they are sent to its output.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	MetricPipeline string `json:"metricPipeline"`
+
+	// SpanMetrics generates the metrics `traces.span.metrics.calls` and `traces.span.metrics.duration` from all spans of the pipeline.
+	// +kubebuilder:validation:Optional
+	SpanMetrics *SpanMetrics `json:"spanMetrics,omitempty"`
+
+	// ServiceGraph generates metrics that describe the requests between services, such as `traces_service_graph_request_total`.
+	// +kubebuilder:validation:Optional
+	ServiceGraph *ServiceGraphMetrics `json:"serviceGraph,omitempty"`
+}
+
+// SpanMetrics defines the generation of span metrics.
+type SpanMetrics struct {
+	// Dimensions defines additional span or resource attributes that are added as attributes to the span metrics. By default, the metrics have the attributes `service.name`, `span.name`, `span.kind`, and `status.code`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	Dimensions []string `json:"dimensions,omitempty"`
+}
+
+// ServiceGraphMetrics defines the generation of service graph metrics.
+type ServiceGraphMetrics struct {
+	// Dimensions defines additional span attributes that are added as attributes to the service graph metrics. By default, the metrics have the attributes `client`, `server`, and `connection_type`.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	Dimensions []string `json:"dimensions,omitempty"`
+}
+
+// TracePipelineSampling defines the sampling configuration of a TracePipeline. If both head-based and tail-based sampling are defined, head-based sampling is applied first.
+// +kubebuilder:validation:XValidation:rule="has(self.head) || has(self.tail)", message="At least one of 'head' or 'tail' must be defined"
+type TracePipelineSampling struct {
+	// Head configures probabilistic head-based sampling. The sampling decision is made based on the trace ID, so all spans of a trace are either kept or dropped.
+	// +kubebuilder:validation:Optional
+	Head *TraceHeadSampling `json:"head,omitempty"`
+
+	// Tail configures tail-based sampling. Complete traces are buffered in the gateway and a sampling decision is made based on the configured policies. A trace is kept if at least one policy matches.
+	// +kubebuilder:validation:Optional
+	Tail *TraceTailSampling `json:"tail,omitempty"`
+}
+
+// TraceHeadSampling defines the probabilistic head-based sampling of a TracePipeline.
+type TraceHeadSampling struct {
+	// Percentage defines the percentage of traces to keep. The value must be between 0 and 100.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int32 `json:"percentage"`
+
+	// Overrides defines different percentages for traces from specific namespaces. If multiple overrides match a namespace, the first one applies.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	Overrides []TraceHeadSamplingOverride `json:"overrides,omitempty"`
+}
+
+// TraceHeadSamplingOverride defines the head-based sampling percentage for traces from specific namespaces.
+type TraceHeadSamplingOverride struct {
+	// Namespaces selects the namespaces to which the percentage applies. You must define either an include list or an exclude list.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="has(self.include) || has(self.exclude)", message="One of 'include' or 'exclude' must be defined"
+	Namespaces NamespaceSelector `json:"namespaces"`
+
+	// Percentage defines the percentage of traces to keep from the selected namespaces. The value must be between 0 and 100.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int32 `json:"percentage"`
+}
+
+// TraceTailSampling defines the tail-based sampling of a TracePipeline.
+type TraceTailSampling struct {
+	// DecisionWait defines the time to wait after the first span of a trace is received before a sampling decision is made. The default is `10s`.
+	// +kubebuilder:validation:Optional
+	DecisionWait *metav1.Duration `json:"decisionWait,omitempty"`
+
+	// Policies defines a list of sampling policies. A trace is sampled if at least one policy matches. Each policy name must be unique.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, y.name == x.name))", message="Policy names must be unique"
+	Policies []TraceSamplingPolicy `json:"policies"`
+}
+
+// TraceSamplingPolicy defines a single tail-based sampling policy. Exactly one of `probabilistic`, `latency`, `statusCode`, or `attribute` must be defined.
+// +kubebuilder:validation:XValidation:rule="(has(self.probabilistic) ? 1 : 0) + (has(self.latency) ? 1 : 0) + (has(self.statusCode) ? 1 : 0) + (has(self.attribute) ? 1 : 0) == 1", message="Exactly one of 'probabilistic', 'latency', 'statusCode', or 'attribute' must be defined"
+type TraceSamplingPolicy struct {
+	// Name identifies the policy. It must be unique within the pipeline.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Probabilistic samples the given percentage of traces.
+	// +kubebuilder:validation:Optional
+	Probabilistic *ProbabilisticSamplingPolicy `json:"probabilistic,omitempty"`
+
+	// Latency samples traces whose duration exceeds the given threshold.
+	// +kubebuilder:validation:Optional
+	Latency *LatencySamplingPolicy `json:"latency,omitempty"`
+
+	// StatusCode samples traces that contain at least one span with one of the given status codes.
+	// +kubebuilder:validation:Optional
+	StatusCode *StatusCodeSamplingPolicy `json:"statusCode,omitempty"`
+
+	// Attribute samples traces that contain at least one span with the given attribute key and one of the given values.
+	// +kubebuilder:validation:Optional
+	Attribute *AttributeSamplingPolicy `json:"attribute,omitempty"`
+}
+
+// ProbabilisticSamplingPolicy samples a percentage of all traces.
+type ProbabilisticSamplingPolicy struct {
+	// Percentage defines the percentage of traces to sample. The value must be between 0 and 100.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage int32 `json:"percentage"`
+}
+
+// LatencySamplingPolicy samples traces whose duration exceeds a threshold.
+type LatencySamplingPolicy struct {
+	// Threshold defines the minimum duration of a trace to be sampled, for example, `500ms`.
+	// +kubebuilder:validation:Required
+	Threshold metav1.Duration `json:"threshold"`
+}
+
+// StatusCodeSamplingPolicy samples traces based on the status codes of their spans.
+type StatusCodeSamplingPolicy struct {
+	// StatusCodes defines the span status codes that cause a trace to be sampled.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=ERROR;OK;UNSET
+	StatusCodes []string `json:"statusCodes"`
+}
+
+// AttributeSamplingPolicy samples traces based on span or resource attribute values.
+type AttributeSamplingPolicy struct {
+	// Key defines the attribute key to match.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Values defines the attribute values to match.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+
+	// EnableRegexMatching enables matching of the values as regular expressions.
+	// +kubebuilder:validation:Optional
+	EnableRegexMatching bool `json:"enableRegexMatching,omitempty"`
 }
 
 // TracePipelineOutput defines the output configuration section.
+// +kubebuilder:validation:XValidation:rule="has(self.otlp) != has(self.kafka)",message="Exactly one output out of 'otlp' or 'kafka' must be defined"
+// +kubebuilder:validation:XValidation:rule="has(self.otlp) || !has(self.failover)",message="Failover output is only supported with otlp output"
 type TracePipelineOutput struct {
 	// OTLP output defines an output using the OpenTelemetry protocol.
-	// +kubebuilder:validation:Required
-	OTLP *OTLPOutput `json:"otlp"`
+	// +kubebuilder:validation:Optional
+	OTLP *OTLPOutput `json:"otlp,omitempty"`
+	// Kafka defines an output that publishes spans to a Kafka topic.
+	// +kubebuilder:validation:Optional
+	Kafka *KafkaOutput `json:"kafka,omitempty"`
+	// Failover defines a secondary backend using the OpenTelemetry protocol. While the backend of the `otlp` output is failing, data is sent to the failover backend instead. Once the primary backend recovers, data is sent to it again.
+	// +kubebuilder:validation:Optional
+	Failover *OTLPOutput `json:"failover,omitempty"`
 }
 
 // TracePipelineStatus defines the observed state of TracePipeline.
@@ -72,4 +252,30 @@ type TracePipelineStatus struct {
 	// An array of conditions describing the status of the pipeline.
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Sampling shows the head-based sampling percentages of the pipeline.
+	// +kubebuilder:validation:Optional
+	Sampling *TracePipelineSamplingStatus `json:"sampling,omitempty"`
+}
+
+// TracePipelineSamplingStatus shows the head-based sampling percentages of a TracePipeline.
+type TracePipelineSamplingStatus struct {
+	// Percentage is the configured default percentage of the pipeline. It is not necessarily the effective percentage of any namespace, because overrides with an exclude list can select all remaining namespaces.
+	// +kubebuilder:validation:Optional
+	Percentage int32 `json:"percentage"`
+
+	// Namespaces shows the effective percentage for each namespace that is explicitly included by an override.
+	// +kubebuilder:validation:Optional
+	Namespaces []NamespaceSamplingStatus `json:"namespaces,omitempty"`
+}
+
+// NamespaceSamplingStatus shows the effective head-based sampling percentage of a namespace.
+type NamespaceSamplingStatus struct {
+	// Name of the namespace.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name"`
+
+	// Percentage is the effective percentage of traces kept from the namespace.
+	// +kubebuilder:validation:Optional
+	Percentage int32 `json:"percentage"`
 }
@@ -25,6 +25,26 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttributeSamplingPolicy) DeepCopyInto(out *AttributeSamplingPolicy) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttributeSamplingPolicy.
+func (in *AttributeSamplingPolicy) DeepCopy() *AttributeSamplingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AttributeSamplingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationOptions) DeepCopyInto(out *AuthenticationOptions) {
 	*out = *in
@@ -67,6 +87,39 @@ func (in *BasicAuthOptions) DeepCopy() *BasicAuthOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchOutput) DeepCopyInto(out *ElasticsearchOutput) {
+	*out = *in
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OutputTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchOutput.
+func (in *ElasticsearchOutput) DeepCopy() *ElasticsearchOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvoyMetrics) DeepCopyInto(out *EnvoyMetrics) {
 	*out = *in
@@ -196,6 +249,89 @@ func (in *Header) DeepCopy() *Header {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaAuthenticationOptions) DeepCopyInto(out *KafkaAuthenticationOptions) {
+	*out = *in
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(KafkaSASLOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaAuthenticationOptions.
+func (in *KafkaAuthenticationOptions) DeepCopy() *KafkaAuthenticationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaAuthenticationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaOutput) DeepCopyInto(out *KafkaOutput) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(KafkaAuthenticationOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OutputTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaOutput.
+func (in *KafkaOutput) DeepCopy() *KafkaOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLOptions) DeepCopyInto(out *KafkaSASLOptions) {
+	*out = *in
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLOptions.
+func (in *KafkaSASLOptions) DeepCopy() *KafkaSASLOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencySamplingPolicy) DeepCopyInto(out *LatencySamplingPolicy) {
+	*out = *in
+	out.Threshold = in.Threshold
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencySamplingPolicy.
+func (in *LatencySamplingPolicy) DeepCopy() *LatencySamplingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencySamplingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipeline) DeepCopyInto(out *LogPipeline) {
 	*out = *in
@@ -248,6 +384,61 @@ func (in *LogPipelineContainerSelector) DeepCopy() *LogPipelineContainerSelector
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineDeduplicate) DeepCopyInto(out *LogPipelineDeduplicate) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineDeduplicate.
+func (in *LogPipelineDeduplicate) DeepCopy() *LogPipelineDeduplicate {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineDeduplicate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineEventsInput) DeepCopyInto(out *LogPipelineEventsInput) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = new(NamespaceSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]EventType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineEventsInput.
+func (in *LogPipelineEventsInput) DeepCopy() *LogPipelineEventsInput {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineEventsInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipelineInput) DeepCopyInto(out *LogPipelineInput) {
 	*out = *in
@@ -258,7 +449,12 @@ func (in *LogPipelineInput) DeepCopyInto(out *LogPipelineInput) {
 	}
 	if in.OTLP != nil {
 		in, out := &in.OTLP, &out.OTLP
-		*out = new(OTLPInput)
+		*out = new(LogPipelineOTLPInput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(LogPipelineEventsInput)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -305,6 +501,85 @@ func (in *LogPipelineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineMinSeverity) DeepCopyInto(out *LogPipelineMinSeverity) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]LogPipelineMinSeverityOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineMinSeverity.
+func (in *LogPipelineMinSeverity) DeepCopy() *LogPipelineMinSeverity {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineMinSeverity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineMinSeverityOverride) DeepCopyInto(out *LogPipelineMinSeverityOverride) {
+	*out = *in
+	in.Namespaces.DeepCopyInto(&out.Namespaces)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineMinSeverityOverride.
+func (in *LogPipelineMinSeverityOverride) DeepCopy() *LogPipelineMinSeverityOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineMinSeverityOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineMultiline) DeepCopyInto(out *LogPipelineMultiline) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineMultiline.
+func (in *LogPipelineMultiline) DeepCopy() *LogPipelineMultiline {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineMultiline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineOTLPInput) DeepCopyInto(out *LogPipelineOTLPInput) {
+	*out = *in
+	in.OTLPInput.DeepCopyInto(&out.OTLPInput)
+	if in.MinSeverity != nil {
+		in, out := &in.MinSeverity, &out.MinSeverity
+		*out = new(LogPipelineMinSeverity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineOTLPInput.
+func (in *LogPipelineOTLPInput) DeepCopy() *LogPipelineOTLPInput {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineOTLPInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipelineOutput) DeepCopyInto(out *LogPipelineOutput) {
 	*out = *in
@@ -318,6 +593,26 @@ func (in *LogPipelineOutput) DeepCopyInto(out *LogPipelineOutput) {
 		*out = new(OTLPOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(ElasticsearchOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(OTLPOutput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineOutput.
@@ -330,6 +625,48 @@ func (in *LogPipelineOutput) DeepCopy() *LogPipelineOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelineParser) DeepCopyInto(out *LogPipelineParser) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineParser.
+func (in *LogPipelineParser) DeepCopy() *LogPipelineParser {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelineParser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogPipelinePodSelector) DeepCopyInto(out *LogPipelinePodSelector) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelinePodSelector.
+func (in *LogPipelinePodSelector) DeepCopy() *LogPipelinePodSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(LogPipelinePodSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogPipelineRuntimeInput) DeepCopyInto(out *LogPipelineRuntimeInput) {
 	*out = *in
@@ -348,6 +685,11 @@ func (in *LogPipelineRuntimeInput) DeepCopyInto(out *LogPipelineRuntimeInput) {
 		*out = new(LogPipelineContainerSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(LogPipelinePodSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FluentBitKeepAnnotations != nil {
 		in, out := &in.FluentBitKeepAnnotations, &out.FluentBitKeepAnnotations
 		*out = new(bool)
@@ -363,6 +705,23 @@ func (in *LogPipelineRuntimeInput) DeepCopyInto(out *LogPipelineRuntimeInput) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Multiline != nil {
+		in, out := &in.Multiline, &out.Multiline
+		*out = make([]LogPipelineMultiline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parser != nil {
+		in, out := &in.Parser, &out.Parser
+		*out = new(LogPipelineParser)
+		**out = **in
+	}
+	if in.MinSeverity != nil {
+		in, out := &in.MinSeverity, &out.MinSeverity
+		*out = new(LogPipelineMinSeverity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineRuntimeInput.
@@ -385,6 +744,13 @@ func (in *LogPipelineSpec) DeepCopyInto(out *LogPipelineSpec) {
 		copy(*out, *in)
 	}
 	in.Output.DeepCopyInto(&out.Output)
+	if in.AdditionalOutputs != nil {
+		in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+		*out = make([]NamedOTLPOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FluentBitFiles != nil {
 		in, out := &in.FluentBitFiles, &out.FluentBitFiles
 		*out = make([]FluentBitFile, len(*in))
@@ -397,6 +763,11 @@ func (in *LogPipelineSpec) DeepCopyInto(out *LogPipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(RedactionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Transforms != nil {
 		in, out := &in.Transforms, &out.Transforms
 		*out = make([]TransformSpec, len(*in))
@@ -411,6 +782,11 @@ func (in *LogPipelineSpec) DeepCopyInto(out *LogPipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Deduplicate != nil {
+		in, out := &in.Deduplicate, &out.Deduplicate
+		*out = new(LogPipelineDeduplicate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineSpec.
@@ -450,6 +826,69 @@ func (in *LogPipelineStatus) DeepCopy() *LogPipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiOutput) DeepCopyInto(out *LokiOutput) {
+	*out = *in
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OutputTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiOutput.
+func (in *LokiOutput) DeepCopy() *LokiOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricAggregation) DeepCopyInto(out *MetricAggregation) {
+	*out = *in
+	if in.MetricNames != nil {
+		in, out := &in.MetricNames, &out.MetricNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeepLabels != nil {
+		in, out := &in.KeepLabels, &out.KeepLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DropLabels != nil {
+		in, out := &in.DropLabels, &out.DropLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricAggregation.
+func (in *MetricAggregation) DeepCopy() *MetricAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricPipeline) DeepCopyInto(out *MetricPipeline) {
 	*out = *in
@@ -477,6 +916,101 @@ func (in *MetricPipeline) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelineHostInput) DeepCopyInto(out *MetricPipelineHostInput) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Scrapers != nil {
+		in, out := &in.Scrapers, &out.Scrapers
+		*out = new(MetricPipelineHostInputScrapers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcludeMountPoints != nil {
+		in, out := &in.ExcludeMountPoints, &out.ExcludeMountPoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineHostInput.
+func (in *MetricPipelineHostInput) DeepCopy() *MetricPipelineHostInput {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelineHostInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelineHostInputScraper) DeepCopyInto(out *MetricPipelineHostInputScraper) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineHostInputScraper.
+func (in *MetricPipelineHostInputScraper) DeepCopy() *MetricPipelineHostInputScraper {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelineHostInputScraper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelineHostInputScrapers) DeepCopyInto(out *MetricPipelineHostInputScrapers) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(MetricPipelineHostInputScraper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MetricPipelineHostInputScraper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(MetricPipelineHostInputScraper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filesystem != nil {
+		in, out := &in.Filesystem, &out.Filesystem
+		*out = new(MetricPipelineHostInputScraper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(MetricPipelineHostInputScraper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(MetricPipelineHostInputScraper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineHostInputScrapers.
+func (in *MetricPipelineHostInputScrapers) DeepCopy() *MetricPipelineHostInputScrapers {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelineHostInputScrapers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricPipelineInput) DeepCopyInto(out *MetricPipelineInput) {
 	*out = *in
@@ -490,6 +1024,11 @@ func (in *MetricPipelineInput) DeepCopyInto(out *MetricPipelineInput) {
 		*out = new(MetricPipelineRuntimeInput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(MetricPipelineHostInput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Istio != nil {
 		in, out := &in.Istio, &out.Istio
 		*out = new(MetricPipelineIstioInput)
@@ -628,6 +1167,26 @@ func (in *MetricPipelineOutput) DeepCopyInto(out *MetricPipelineOutput) {
 		*out = new(MetricPipelineOTLPOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusRemoteWrite != nil {
+		in, out := &in.PrometheusRemoteWrite, &out.PrometheusRemoteWrite
+		*out = new(PrometheusRemoteWriteOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusExpositionOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(OTLPOutput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineOutput.
@@ -658,6 +1217,11 @@ func (in *MetricPipelinePrometheusInput) DeepCopyInto(out *MetricPipelinePrometh
 		*out = new(MetricPipelineIstioInputDiagnosticMetrics)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Monitors != nil {
+		in, out := &in.Monitors, &out.Monitors
+		*out = new(MetricPipelinePrometheusInputMonitors)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelinePrometheusInput.
@@ -670,6 +1234,26 @@ func (in *MetricPipelinePrometheusInput) DeepCopy() *MetricPipelinePrometheusInp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricPipelinePrometheusInputMonitors) DeepCopyInto(out *MetricPipelinePrometheusInputMonitors) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelinePrometheusInputMonitors.
+func (in *MetricPipelinePrometheusInputMonitors) DeepCopy() *MetricPipelinePrometheusInputMonitors {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricPipelinePrometheusInputMonitors)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricPipelineRuntimeInput) DeepCopyInto(out *MetricPipelineRuntimeInput) {
 	*out = *in
@@ -785,6 +1369,18 @@ func (in *MetricPipelineSpec) DeepCopyInto(out *MetricPipelineSpec) {
 	*out = *in
 	in.Input.DeepCopyInto(&out.Input)
 	in.Output.DeepCopyInto(&out.Output)
+	if in.AdditionalOutputs != nil {
+		in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+		*out = make([]NamedOTLPOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(RedactionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Transforms != nil {
 		in, out := &in.Transforms, &out.Transforms
 		*out = make([]TransformSpec, len(*in))
@@ -799,6 +1395,13 @@ func (in *MetricPipelineSpec) DeepCopyInto(out *MetricPipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Aggregations != nil {
+		in, out := &in.Aggregations, &out.Aggregations
+		*out = make([]MetricAggregation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricPipelineSpec.
@@ -833,6 +1436,41 @@ func (in *MetricPipelineStatus) DeepCopy() *MetricPipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedOTLPOutput) DeepCopyInto(out *NamedOTLPOutput) {
+	*out = *in
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPOutput)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedOTLPOutput.
+func (in *NamedOTLPOutput) DeepCopy() *NamedOTLPOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedOTLPOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceSamplingStatus) DeepCopyInto(out *NamespaceSamplingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceSamplingStatus.
+func (in *NamespaceSamplingStatus) DeepCopy() *NamespaceSamplingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceSamplingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceSelector) DeepCopyInto(out *NamespaceSelector) {
 	*out = *in
@@ -934,6 +1572,11 @@ func (in *OTLPOutput) DeepCopyInto(out *OTLPOutput) {
 		*out = new(OutputTLS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PersistentQueue != nil {
+		in, out := &in.PersistentQueue, &out.PersistentQueue
+		*out = new(PersistentQueue)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPOutput.
@@ -976,6 +1619,129 @@ func (in *OutputTLS) DeepCopy() *OutputTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentQueue) DeepCopyInto(out *PersistentQueue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentQueue.
+func (in *PersistentQueue) DeepCopy() *PersistentQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbabilisticSamplingPolicy) DeepCopyInto(out *ProbabilisticSamplingPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbabilisticSamplingPolicy.
+func (in *ProbabilisticSamplingPolicy) DeepCopy() *ProbabilisticSamplingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbabilisticSamplingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusExpositionOutput) DeepCopyInto(out *PrometheusExpositionOutput) {
+	*out = *in
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Staleness != nil {
+		in, out := &in.Staleness, &out.Staleness
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusExpositionOutput.
+func (in *PrometheusExpositionOutput) DeepCopy() *PrometheusExpositionOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusExpositionOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWriteOutput) DeepCopyInto(out *PrometheusRemoteWriteOutput) {
+	*out = *in
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OutputTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceAttributesToLabels != nil {
+		in, out := &in.ResourceAttributesToLabels, &out.ResourceAttributesToLabels
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWriteOutput.
+func (in *PrometheusRemoteWriteOutput) DeepCopy() *PrometheusRemoteWriteOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWriteOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedactionSpec) DeepCopyInto(out *RedactionSpec) {
+	*out = *in
+	if in.Detectors != nil {
+		in, out := &in.Detectors, &out.Detectors
+		*out = make([]RedactionDetector, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedKeys != nil {
+		in, out := &in.AllowedKeys, &out.AllowedKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactionSpec.
+func (in *RedactionSpec) DeepCopy() *RedactionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedactionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
 	*out = *in
@@ -991,6 +1757,104 @@ func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceGraphMetrics) DeepCopyInto(out *ServiceGraphMetrics) {
+	*out = *in
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceGraphMetrics.
+func (in *ServiceGraphMetrics) DeepCopy() *ServiceGraphMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceGraphMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanMetrics) DeepCopyInto(out *SpanMetrics) {
+	*out = *in
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanMetrics.
+func (in *SpanMetrics) DeepCopy() *SpanMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusCodeSamplingPolicy) DeepCopyInto(out *StatusCodeSamplingPolicy) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusCodeSamplingPolicy.
+func (in *StatusCodeSamplingPolicy) DeepCopy() *StatusCodeSamplingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCodeSamplingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceHeadSampling) DeepCopyInto(out *TraceHeadSampling) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]TraceHeadSamplingOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceHeadSampling.
+func (in *TraceHeadSampling) DeepCopy() *TraceHeadSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceHeadSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceHeadSamplingOverride) DeepCopyInto(out *TraceHeadSamplingOverride) {
+	*out = *in
+	in.Namespaces.DeepCopyInto(&out.Namespaces)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceHeadSamplingOverride.
+func (in *TraceHeadSamplingOverride) DeepCopy() *TraceHeadSamplingOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceHeadSamplingOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipeline) DeepCopyInto(out *TracePipeline) {
 	*out = *in
@@ -1050,6 +1914,31 @@ func (in *TracePipelineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineMetrics) DeepCopyInto(out *TracePipelineMetrics) {
+	*out = *in
+	if in.SpanMetrics != nil {
+		in, out := &in.SpanMetrics, &out.SpanMetrics
+		*out = new(SpanMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceGraph != nil {
+		in, out := &in.ServiceGraph, &out.ServiceGraph
+		*out = new(ServiceGraphMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineMetrics.
+func (in *TracePipelineMetrics) DeepCopy() *TracePipelineMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipelineOutput) DeepCopyInto(out *TracePipelineOutput) {
 	*out = *in
@@ -1058,6 +1947,16 @@ func (in *TracePipelineOutput) DeepCopyInto(out *TracePipelineOutput) {
 		*out = new(OTLPOutput)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(OTLPOutput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineOutput.
@@ -1070,10 +1969,67 @@ func (in *TracePipelineOutput) DeepCopy() *TracePipelineOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineSampling) DeepCopyInto(out *TracePipelineSampling) {
+	*out = *in
+	if in.Head != nil {
+		in, out := &in.Head, &out.Head
+		*out = new(TraceHeadSampling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tail != nil {
+		in, out := &in.Tail, &out.Tail
+		*out = new(TraceTailSampling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineSampling.
+func (in *TracePipelineSampling) DeepCopy() *TracePipelineSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracePipelineSamplingStatus) DeepCopyInto(out *TracePipelineSamplingStatus) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceSamplingStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineSamplingStatus.
+func (in *TracePipelineSamplingStatus) DeepCopy() *TracePipelineSamplingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TracePipelineSamplingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracePipelineSpec) DeepCopyInto(out *TracePipelineSpec) {
 	*out = *in
 	in.Output.DeepCopyInto(&out.Output)
+	if in.AdditionalOutputs != nil {
+		in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+		*out = make([]NamedOTLPOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(RedactionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Transforms != nil {
 		in, out := &in.Transforms, &out.Transforms
 		*out = make([]TransformSpec, len(*in))
@@ -1088,6 +2044,16 @@ func (in *TracePipelineSpec) DeepCopyInto(out *TracePipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(TracePipelineSampling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(TracePipelineMetrics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineSpec.
@@ -1110,6 +2076,11 @@ func (in *TracePipelineStatus) DeepCopyInto(out *TracePipelineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(TracePipelineSamplingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracePipelineStatus.
@@ -1122,6 +2093,68 @@ func (in *TracePipelineStatus) DeepCopy() *TracePipelineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceSamplingPolicy) DeepCopyInto(out *TraceSamplingPolicy) {
+	*out = *in
+	if in.Probabilistic != nil {
+		in, out := &in.Probabilistic, &out.Probabilistic
+		*out = new(ProbabilisticSamplingPolicy)
+		**out = **in
+	}
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(LatencySamplingPolicy)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(StatusCodeSamplingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attribute != nil {
+		in, out := &in.Attribute, &out.Attribute
+		*out = new(AttributeSamplingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceSamplingPolicy.
+func (in *TraceSamplingPolicy) DeepCopy() *TraceSamplingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceSamplingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceTailSampling) DeepCopyInto(out *TraceTailSampling) {
+	*out = *in
+	if in.DecisionWait != nil {
+		in, out := &in.DecisionWait, &out.DecisionWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]TraceSamplingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceTailSampling.
+func (in *TraceTailSampling) DeepCopy() *TraceTailSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceTailSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransformSpec) DeepCopyInto(out *TransformSpec) {
 	*out = *in
@@ -60,7 +60,9 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	predicateutils "github.com/kyma-project/telemetry-manager/internal/utils/predicate"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
+	"github.com/kyma-project/telemetry-manager/internal/validators/multiline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/redaction"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 	"github.com/kyma-project/telemetry-manager/internal/vpastatus"
@@ -423,6 +425,8 @@ func configureOTelReconciler(config LogPipelineControllerConfig, client client.C
 		logpipelineotel.WithSecretRefValidator(&secretref.Validator{Client: client}),
 		logpipelineotel.WithTransformSpecValidator(transformSpecValidator),
 		logpipelineotel.WithFilterSpecValidator(filterSpecValidator),
+		logpipelineotel.WithMultilineValidator(&multiline.Validator{}),
+		logpipelineotel.WithRedactionValidator(&redaction.Validator{}),
 	)
 
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config.RestConfig)
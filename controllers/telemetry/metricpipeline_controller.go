@@ -19,19 +19,27 @@ package telemetry
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
 
 	istiosecurityclientv1 "istio.io/client-go/pkg/apis/security/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	autoscalingvpav1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -54,9 +62,11 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
 	"github.com/kyma-project/telemetry-manager/internal/secretwatch"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
 	predicateutils "github.com/kyma-project/telemetry-manager/internal/utils/predicate"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/redaction"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
@@ -75,6 +85,14 @@ type MetricPipelineController struct {
 	secretWatchClient    *secretwatch.Client
 	pipelineLockName     types.NamespacedName
 	nodeSizeTracker      *nodesize.Tracker
+
+	// The ServiceMonitor and PodMonitor CRDs can be installed after the manager has started,
+	// so the watches of the monitor types are added by the reconciliation once the CRDs are served.
+	controller      controller.Controller
+	cache           cache.Cache
+	discoveryClient discovery.DiscoveryInterface
+	monitorWatchMu  sync.Mutex
+	watchedMonitors map[schema.GroupVersionKind]bool
 }
 
 type MetricPipelineControllerConfig struct {
@@ -137,6 +155,7 @@ func NewMetricPipelineController(config MetricPipelineControllerConfig, client c
 		metricpipeline.WithTransformSpecValidator(transformSpecValidator),
 		metricpipeline.WithFilterSpecValidator(filterSpecValidator),
 		metricpipeline.WithRuntimeAdditionalMetricsValidator(&runtimemetrics.Validator{}),
+		metricpipeline.WithRedactionValidator(&redaction.Validator{}),
 	)
 
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config.RestConfig)
@@ -185,9 +204,55 @@ func NewMetricPipelineController(config MetricPipelineControllerConfig, client c
 }
 
 func (r *MetricPipelineController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if err := r.watchPrometheusMonitors(ctx, req); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	return r.reconciler.Reconcile(ctx, req)
 }
 
+// watchPrometheusMonitors adds the watches of the ServiceMonitor and PodMonitor types that are served by the cluster but not watched yet.
+// The discovery is only checked for pipelines that select monitors, since the monitor CRDs are irrelevant for all other pipelines.
+func (r *MetricPipelineController) watchPrometheusMonitors(ctx context.Context, req ctrl.Request) error {
+	r.monitorWatchMu.Lock()
+	defer r.monitorWatchMu.Unlock()
+
+	if r.controller == nil || len(r.watchedMonitors) == len(prometheusMonitorGVKs) {
+		return nil
+	}
+
+	var pipeline telemetryv1beta1.MetricPipeline
+	if err := r.Get(ctx, req.NamespacedName, &pipeline); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if !metricpipelineutils.IsPrometheusMonitorsInputEnabled(pipeline.Spec.Input) {
+		return nil
+	}
+
+	monitorTypes, err := prometheusMonitorTypes(r.discoveryClient)
+	if err != nil {
+		return fmt.Errorf("failed to check Prometheus Operator CRDs: %w", err)
+	}
+
+	for _, monitorType := range monitorTypes {
+		gvk := monitorType.GetObjectKind().GroupVersionKind()
+		if r.watchedMonitors[gvk] {
+			continue
+		}
+
+		if err := r.controller.Watch(source.Kind(r.cache, monitorType, handler.EnqueueRequestsFromMapFunc(r.mapPrometheusMonitorChanges), ctrlpredicate.GenerationChangedPredicate{})); err != nil {
+			return fmt.Errorf("failed to watch %s resources: %w", gvk.Kind, err)
+		}
+
+		logf.FromContext(ctx).Info("Prometheus Operator CRD found, started watching its resources", "kind", gvk.Kind)
+
+		r.watchedMonitors[gvk] = true
+	}
+
+	return nil
+}
+
 // TODO: Mainly for Metric Agent reconciliation, should be moved to agent controller after migration.
 // metricPipelineOwnedResourceTypes returns the list of Kubernetes resource types that are
 // managed (created/updated/deleted) by the MetricPipeline reconciler and must be watched for changes.
@@ -305,7 +370,65 @@ func (r *MetricPipelineController) SetupWithManager(mgr ctrl.Manager) error {
 		handler.EnqueueRequestsFromMapFunc(r.mapNodeChanges),
 	)
 
-	return b.Complete(r)
+	// Only watch ServiceMonitor and PodMonitor CRs if the Prometheus Operator CRDs exist in the cluster
+	// otherwise, manager will have errors if the CRDs are not present in the cluster
+	monitorTypes, err := prometheusMonitorTypes(discoveryClient)
+	if err != nil {
+		return fmt.Errorf("failed to check Prometheus Operator CRDs: %w", err)
+	}
+
+	r.watchedMonitors = make(map[schema.GroupVersionKind]bool)
+
+	// Watch for spec changes in ServiceMonitors and PodMonitors to regenerate the scrape configs of the metric agent
+	for _, monitorType := range monitorTypes {
+		b.Watches(
+			monitorType,
+			handler.EnqueueRequestsFromMapFunc(r.mapPrometheusMonitorChanges),
+			ctrlbuilder.WithPredicates(ctrlpredicate.GenerationChangedPredicate{}),
+		)
+
+		r.watchedMonitors[monitorType.GetObjectKind().GroupVersionKind()] = true
+	}
+
+	c, err := b.Build(r)
+	if err != nil {
+		return err
+	}
+
+	// Monitor types whose CRDs are installed later are watched once a pipeline selecting monitors is reconciled
+	r.controller = c
+	r.cache = mgr.GetCache()
+	r.discoveryClient = discoveryClient
+
+	return nil
+}
+
+var prometheusMonitorGVKs = []schema.GroupVersionKind{metricagent.ServiceMonitorGVK, metricagent.PodMonitorGVK}
+
+// prometheusMonitorTypes returns the ServiceMonitor and PodMonitor types that are served by the cluster.
+func prometheusMonitorTypes(discoveryClient discovery.DiscoveryInterface) ([]client.Object, error) {
+	apiResourceList, err := discoveryClient.ServerResourcesForGroupVersion(names.MonitoringGroupVersion)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var monitorTypes []client.Object
+
+	for _, gvk := range prometheusMonitorGVKs {
+		if !slices.ContainsFunc(apiResourceList.APIResources, func(r metav1.APIResource) bool { return r.Kind == gvk.Kind }) {
+			continue
+		}
+
+		monitorType := &unstructured.Unstructured{}
+		monitorType.SetGroupVersionKind(gvk)
+		monitorTypes = append(monitorTypes, monitorType)
+	}
+
+	return monitorTypes, nil
 }
 
 // mapLockConfigMapChanges enqueues reconciliation requests for all MetricPipelines when the pipeline lock
@@ -370,6 +493,13 @@ func (r *MetricPipelineController) mapNodeChanges(ctx context.Context, object cl
 	return r.enqueueAllPipelines(ctx)
 }
 
+// mapPrometheusMonitorChanges enqueues reconciliation requests for all MetricPipelines when a ServiceMonitor
+// or PodMonitor changes. This ensures that the scrape configs of the metric agent reflect the current monitors.
+func (r *MetricPipelineController) mapPrometheusMonitorChanges(ctx context.Context, object client.Object) []reconcile.Request {
+	logf.FromContext(ctx).V(1).Info("Prometheus monitor changed, triggering reconciliation of all MetricPipelines", "kind", object.GetObjectKind().GroupVersionKind().Kind)
+	return r.enqueueAllPipelines(ctx)
+}
+
 // enqueueAllPipelines lists all MetricPipelines and returns a reconcile request for each one.
 func (r *MetricPipelineController) enqueueAllPipelines(ctx context.Context) []reconcile.Request {
 	var pipelineList telemetryv1beta1.MetricPipelineList
@@ -46,6 +46,7 @@ import (
 	predicateutils "github.com/kyma-project/telemetry-manager/internal/utils/predicate"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/redaction"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 	"github.com/kyma-project/telemetry-manager/internal/workloadstatus"
@@ -114,6 +115,7 @@ func NewTracePipelineController(config TracePipelineControllerConfig, client cli
 		tracepipeline.WithValidatorPipelineLock(pipelineLock),
 		tracepipeline.WithTransformSpecValidator(transformSpecValidator),
 		tracepipeline.WithFilterSpecValidator(filterSpecValidator),
+		tracepipeline.WithRedactionValidator(&redaction.Validator{}),
 	)
 
 	reconciler := tracepipeline.New(
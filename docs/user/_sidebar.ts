@@ -9,6 +9,7 @@ export default [
     text: 'Collecting Logs', link: './collecting-logs/README', collapsed: true, items: [
       { text: 'Configure Application Logs', link: './collecting-logs/runtime-input' },
       { text: 'Configure Istio Access Logs', link: './collecting-logs/istio-support' },
+      { text: 'Collect Kubernetes Events', link: './collecting-logs/events-input' },
     ]
   },
   {
@@ -21,6 +22,7 @@ export default [
       { text: 'Collect Prometheus Metrics', link: './collecting-metrics/prometheus-input' },
       { text: 'Collect Istio Metrics', link: './collecting-metrics/istio-input' },
       { text: 'Collect Runtime Metrics', link: './collecting-metrics/runtime-input' },
+      { text: 'Collect Host Metrics', link: './collecting-metrics/host-input' },
     ]
   },
   {
@@ -32,6 +34,7 @@ export default [
         { text: 'Transform with OTTL', link: './filter-and-process/ottl-transform-and-filter/ottl-transform' },
         { text: 'Filter with OTTL', link: './filter-and-process/ottl-transform-and-filter/ottl-filter' },
       ]},
+      { text: 'Redact Sensitive Data', link: './filter-and-process/redact-sensitive-data' },
       { text: 'Transformation to OTLP Logs', link: './filter-and-process/transformation-to-otlp-logs' },
       { text: 'Automatic Data Enrichment', link: './filter-and-process/automatic-data-enrichment' }
     ]
@@ -6,6 +6,8 @@ const (
 	LinkNoDataArriveAtBackend     = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#no-data-arrive-at-the-backend"
 	LinkNotAllDataArriveAtBackend = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#not-all-data-arrive-at-the-backend"
 	LinkGatewayThrottling         = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#gateway-throttling"
+	LinkBufferFillingUp           = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#exporter-buffer-filling-up"
+	LinkSampleLimitExceeded       = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#metricpipeline-prometheus-sample-limit-exceeded"
 	LinkOTTLSpecInvalid           = "https://kyma-project.io/external-content/telemetry-manager/docs/user/troubleshooting.html#ottl-spec-invalid-with-unspecific-error-message"
 
 	LinkFluentBitNoLogsArriveAtBackend     = "https://kyma-project.io/#/telemetry-manager/user/02-logs?id=no-logs-arrive-at-the-backend"
@@ -40,9 +42,12 @@ const (
 	ReasonSelfMonGatewaySomeDataDropped    = "GatewaySomeTelemetryDataDropped"
 	ReasonSelfMonAgentSomeDataDropped      = "AgentSomeTelemetryDataDropped"
 	ReasonSelfMonAgentBufferFillingUp      = "AgentBufferFillingUp"
+	ReasonSelfMonGatewayBufferFillingUp    = "GatewayBufferFillingUp"
 	ReasonSelfMonGatewayProbingFailed      = "GatewayProbingFailed"
 	ReasonSelfMonAgentProbingFailed        = "AgentProbingFailed"
 	ReasonSelfMonGatewayThrottling         = "GatewayThrottling"
+	ReasonSelfMonAgentSampleLimitExceeded  = "AgentSampleLimitExceeded"
+	ReasonSelfMonFailoverActive            = "FailoverOutputActive"
 	ReasonSelfMonConfigNotGenerated        = "ConfigurationNotGenerated"
 	ReasonGatewayConfigurationNotGenerated = "GatewayConfigurationNotGenerated"
 	ReasonTLSCertificateAboutToExpire      = "TLSCertificateAboutToExpire"
@@ -52,6 +57,8 @@ const (
 	ReasonRolloutInProgress                = "RolloutInProgress"
 	ReasonOTTLSpecInvalid                  = "OTTLSpecInvalid"
 	ReasonRuntimeAdditionalMetricInvalid   = "RuntimeAdditionalMetricInvalid"
+	ReasonMultilinePatternInvalid          = "MultilinePatternInvalid"
+	ReasonRedactionPatternInvalid          = "RedactionPatternInvalid"
 
 	// Telemetry reasons
 
@@ -123,6 +130,9 @@ var otelLogPipelineMessages = map[string]string{
 	ReasonSelfMonGatewayAllDataDropped:  "Backend is not reachable or rejecting logs. All logs are dropped in OTLP Gateway. See troubleshooting: " + LinkNoDataArriveAtBackend,
 	ReasonSelfMonGatewaySomeDataDropped: "Backend is reachable, but rejecting logs. Some logs are dropped in OTLP Gateway. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
 	ReasonSelfMonGatewayThrottling:      "OTLP Gateway is unable to receive logs at current rate. See troubleshooting: " + LinkGatewayThrottling,
+	ReasonSelfMonGatewayBufferFillingUp: "Buffer nearing capacity. Incoming log rate exceeds export rate in OTLP Gateway. See troubleshooting: " + LinkBufferFillingUp,
+	ReasonSelfMonAgentBufferFillingUp:   "Buffer nearing capacity. Incoming log rate exceeds export rate in Log Agent. See troubleshooting: " + LinkBufferFillingUp,
+	ReasonSelfMonFailoverActive:         "Primary backend is not reachable or rejecting logs. The logs are sent to the failover backend until the primary backend recovers",
 }
 
 var tracePipelineMessages = map[string]string{
@@ -136,6 +146,8 @@ var tracePipelineMessages = map[string]string{
 	ReasonSelfMonGatewayAllDataDropped:  "Backend is not reachable or rejecting spans. All spans are dropped in OTLP Gateway. See troubleshooting: " + LinkNoDataArriveAtBackend,
 	ReasonSelfMonGatewaySomeDataDropped: "Backend is reachable, but rejecting spans. Some spans are dropped in OTLP Gateway. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
 	ReasonSelfMonGatewayThrottling:      "OTLP Gateway is unable to receive spans at current rate. See troubleshooting: " + LinkGatewayThrottling,
+	ReasonSelfMonGatewayBufferFillingUp: "Buffer nearing capacity. Incoming span rate exceeds export rate in OTLP Gateway. See troubleshooting: " + LinkBufferFillingUp,
+	ReasonSelfMonFailoverActive:         "Primary backend is not reachable or rejecting spans. The spans are sent to the failover backend until the primary backend recovers",
 }
 
 var metricPipelineMessages = map[string]string{
@@ -147,12 +159,21 @@ var metricPipelineMessages = map[string]string{
 	ReasonGatewayConfigured:                "MetricPipeline specification is successfully applied to the configuration of OTLP Gateway",
 	ReasonGatewayConfigurationNotGenerated: "This MetricPipeline's specification is not applied to the configuration of the OTLP gateway. Check the 'ConfigurationGenerated' condition for more details",
 
-	ReasonSelfMonAgentAllDataDropped:    "Backend is not reachable or rejecting metrics. All metrics are dropped in Metric Agent. See troubleshooting: " + LinkNoDataArriveAtBackend,
-	ReasonSelfMonAgentSomeDataDropped:   "Backend is reachable, but rejecting metrics. Some metrics are dropped in Metric Agent. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
-	ReasonSelfMonConfigNotGenerated:     "No metrics delivered to backend because MetricPipeline specification is not applied to the configuration of OTLP Gateway. Check the 'ConfigurationGenerated' condition for more details",
-	ReasonSelfMonGatewayAllDataDropped:  "Backend is not reachable or rejecting metrics. All metrics are dropped in OTLP Gateway. See troubleshooting: " + LinkNoDataArriveAtBackend,
-	ReasonSelfMonGatewaySomeDataDropped: "Backend is reachable, but rejecting metrics. Some metrics are dropped in OTLP Gateway. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
-	ReasonSelfMonGatewayThrottling:      "OTLP Gateway is unable to receive metrics at current rate. See troubleshooting: " + LinkGatewayThrottling,
+	ReasonSelfMonAgentAllDataDropped:      "Backend is not reachable or rejecting metrics. All metrics are dropped in Metric Agent. See troubleshooting: " + LinkNoDataArriveAtBackend,
+	ReasonSelfMonAgentSomeDataDropped:     "Backend is reachable, but rejecting metrics. Some metrics are dropped in Metric Agent. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
+	ReasonSelfMonConfigNotGenerated:       "No metrics delivered to backend because MetricPipeline specification is not applied to the configuration of OTLP Gateway. Check the 'ConfigurationGenerated' condition for more details",
+	ReasonSelfMonGatewayAllDataDropped:    "Backend is not reachable or rejecting metrics. All metrics are dropped in OTLP Gateway. See troubleshooting: " + LinkNoDataArriveAtBackend,
+	ReasonSelfMonGatewaySomeDataDropped:   "Backend is reachable, but rejecting metrics. Some metrics are dropped in OTLP Gateway. See troubleshooting: " + LinkNotAllDataArriveAtBackend,
+	ReasonSelfMonGatewayThrottling:        "OTLP Gateway is unable to receive metrics at current rate. See troubleshooting: " + LinkGatewayThrottling,
+	ReasonSelfMonGatewayBufferFillingUp:   "Buffer nearing capacity. Incoming metric rate exceeds export rate in OTLP Gateway. See troubleshooting: " + LinkBufferFillingUp,
+	ReasonSelfMonAgentBufferFillingUp:     "Buffer nearing capacity. Incoming metric rate exceeds export rate in Metric Agent. See troubleshooting: " + LinkBufferFillingUp,
+	ReasonSelfMonAgentSampleLimitExceeded: "A Prometheus target exceeds the sample limit and is not scraped by Metric Agent. The target cannot be attributed to a pipeline, so all pipelines with the Prometheus input report this reason. See troubleshooting: " + LinkSampleLimitExceeded,
+	ReasonSelfMonFailoverActive:           "Primary backend is not reachable or rejecting metrics. The metrics are sent to the failover backend until the primary backend recovers",
+}
+
+// TypeFlowHealthyForOutput returns the type of the TelemetryFlowHealthy sub-condition that reflects the data flow to the additional pipeline output with the given name.
+func TypeFlowHealthyForOutput(outputName string) string {
+	return TypeFlowHealthy + "-" + outputName
 }
 
 func MessageForOtelLogPipeline(reason string) string {
@@ -37,6 +37,7 @@ func buildFluentBitSectionsConfig(pipeline *telemetryv1beta1.LogPipeline, config
 	sb.WriteString(createCustomFilters(pipeline, multilineFilter))
 	sb.WriteString(createRecordModifierFilter(pipeline, clusterName))
 	sb.WriteString(createKubernetesFilter(pipeline))
+	sb.WriteString(createMinSeverityFilters(pipeline))
 	sb.WriteString(createTimestampModifyFilter(pipeline))
 	sb.WriteString(createCustomFilters(pipeline, nonMultilineFilter))
 	sb.WriteString(createLuaFilter(pipeline))
@@ -0,0 +1,85 @@
+package builder
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+const namespaceRecordAccessor = "$kubernetes['namespace_name']"
+
+// lowerSeverityLevels lists the values of the level key of the logs below each severity, matching the severity parsing of OpenTelemetry-based pipelines
+var lowerSeverityLevels = map[telemetryv1beta1.LogSeverity][]string{
+	telemetryv1beta1.LogSeverityTrace: nil,
+	telemetryv1beta1.LogSeverityDebug: {"trace"},
+	telemetryv1beta1.LogSeverityInfo:  {"trace", "debug"},
+	telemetryv1beta1.LogSeverityWarn:  {"trace", "debug", "info"},
+	telemetryv1beta1.LogSeverityError: {"trace", "debug", "info", "warn", "warning"},
+	telemetryv1beta1.LogSeverityFatal: {"trace", "debug", "info", "warn", "warning", "error"},
+}
+
+// createMinSeverityFilters creates grep filters that drop the logs whose level key has a lower severity than the minimum severity of the runtime input.
+// Logs without a level key are kept. Namespace overrides are evaluated in order, and the first matching one applies.
+// As the rules of a grep filter are combined with AND, every override and the default level become a separate filter.
+func createMinSeverityFilters(pipeline *telemetryv1beta1.LogPipeline) string {
+	if pipeline.Spec.Input.Runtime == nil || pipeline.Spec.Input.Runtime.MinSeverity == nil {
+		return ""
+	}
+
+	minSeverity := pipeline.Spec.Input.Runtime.MinSeverity
+
+	var (
+		filters []string
+		// namespace patterns that match if none of the previous overrides matches
+		notMatchedByPrevious []string
+	)
+
+	for _, override := range minSeverity.Overrides {
+		matches, notMatches := namespaceSelectorPatterns(&override.Namespaces)
+
+		if filter := createSeverityGrepFilter(pipeline.Name, slices.Concat(notMatchedByPrevious, []string{matches}), override.Level); filter != "" {
+			filters = append(filters, filter)
+		}
+
+		notMatchedByPrevious = append(notMatchedByPrevious, notMatches)
+	}
+
+	if filter := createSeverityGrepFilter(pipeline.Name, notMatchedByPrevious, minSeverity.Level); filter != "" {
+		filters = append(filters, filter)
+	}
+
+	return strings.Join(filters, "")
+}
+
+func createSeverityGrepFilter(pipelineName string, namespacePatterns []string, level telemetryv1beta1.LogSeverity) string {
+	lowerLevels := lowerSeverityLevels[level]
+	if len(lowerLevels) == 0 {
+		return ""
+	}
+
+	builder := NewFilterSectionBuilder().
+		AddConfigParam("name", "grep").
+		AddConfigParam("match", fmt.Sprintf("%s.*", pipelineName)).
+		AddConfigParam("logical_op", "and").
+		AddConfigParam("exclude", fmt.Sprintf("level (?i)^(%s)$", strings.Join(lowerLevels, "|")))
+
+	for _, pattern := range namespacePatterns {
+		builder.AddConfigParam("exclude", fmt.Sprintf("%s %s", namespaceRecordAccessor, pattern))
+	}
+
+	return builder.Build()
+}
+
+// namespaceSelectorPatterns returns regular expressions that match the namespaces selected and not selected by the given selector.
+func namespaceSelectorPatterns(namespaceSelector *telemetryv1beta1.NamespaceSelector) (matches string, notMatches string) {
+	if len(namespaceSelector.Include) > 0 {
+		namespaces := strings.Join(namespaceSelector.Include, "|")
+		return fmt.Sprintf("^(%s)$", namespaces), fmt.Sprintf("^(?!(%s)$)", namespaces)
+	}
+
+	namespaces := strings.Join(namespaceSelector.Exclude, "|")
+
+	return fmt.Sprintf("^(?!(%s)$)", namespaces), fmt.Sprintf("^(%s)$", namespaces)
+}
@@ -0,0 +1,80 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestCreateMinSeverityFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		pipeline telemetryv1beta1.LogPipeline
+		want     string
+	}{
+		{
+			name:     "no min severity",
+			pipeline: testutils.NewLogPipelineBuilder().WithName("foo").Build(),
+		},
+		{
+			name: "lowest level keeps all logs",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithName("foo").
+				WithMinSeverity(&telemetryv1beta1.LogPipelineMinSeverity{Level: telemetryv1beta1.LogSeverityTrace}).
+				Build(),
+		},
+		{
+			name: "level without overrides",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithName("foo").
+				WithMinSeverity(&telemetryv1beta1.LogPipelineMinSeverity{Level: telemetryv1beta1.LogSeverityInfo}).
+				Build(),
+			want: `[FILTER]
+    name       grep
+    match      foo.*
+    exclude    level (?i)^(trace|debug)$
+    logical_op and
+
+`,
+		},
+		{
+			name: "level with overrides",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithName("foo").
+				WithMinSeverity(&telemetryv1beta1.LogPipelineMinSeverity{
+					Level: telemetryv1beta1.LogSeverityWarn,
+					Overrides: []telemetryv1beta1.LogPipelineMinSeverityOverride{
+						{Namespaces: telemetryv1beta1.NamespaceSelector{Include: []string{"debugging"}}, Level: telemetryv1beta1.LogSeverityTrace},
+						{Namespaces: telemetryv1beta1.NamespaceSelector{Exclude: []string{"production", "staging"}}, Level: telemetryv1beta1.LogSeverityDebug},
+					},
+				}).
+				Build(),
+			want: `[FILTER]
+    name       grep
+    match      foo.*
+    exclude    $kubernetes['namespace_name'] ^(?!(debugging)$)
+    exclude    $kubernetes['namespace_name'] ^(?!(production|staging)$)
+    exclude    level (?i)^(trace)$
+    logical_op and
+
+[FILTER]
+    name       grep
+    match      foo.*
+    exclude    $kubernetes['namespace_name'] ^(?!(debugging)$)
+    exclude    $kubernetes['namespace_name'] ^(production|staging)$
+    exclude    level (?i)^(trace|debug|info)$
+    logical_op and
+
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, createMinSeverityFilters(&tt.pipeline))
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package fluentbitmigration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+const (
+	// AnnotationKeyMigration requests the conversion of a Fluent Bit-based LogPipeline into an OpenTelemetry-based one.
+	AnnotationKeyMigration = "telemetry.kyma-project.io/otel-migration"
+	// AnnotationValueMigrationDryRun writes the converted LogPipeline to the AnnotationKeyMigrationResult annotation without creating it.
+	AnnotationValueMigrationDryRun = "dry-run"
+	// AnnotationKeyMigrationResult holds the converted LogPipeline and the settings that could not be translated.
+	AnnotationKeyMigrationResult = "telemetry.kyma-project.io/otel-migration-result"
+
+	defaultHTTPPort = "443"
+	defaultHTTPURI  = "/"
+)
+
+// Result is the outcome of the conversion of a Fluent Bit-based LogPipeline.
+type Result struct {
+	// Spec is the spec of the equivalent OpenTelemetry-based LogPipeline.
+	Spec telemetryv1beta1.LogPipelineSpec `json:"spec"`
+	// Untranslated lists the settings that could not be translated and must be migrated manually.
+	Untranslated []string `json:"untranslated,omitempty"`
+}
+
+// Report returns the result in the format of the AnnotationKeyMigrationResult annotation.
+func (r Result) Report() (string, error) {
+	report, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal migration result: %w", err)
+	}
+
+	return string(report), nil
+}
+
+// Convert translates a Fluent Bit-based LogPipeline into an equivalent OpenTelemetry-based LogPipeline spec.
+// Settings without an OpenTelemetry-based counterpart are skipped and reported in the result.
+func Convert(pipeline *telemetryv1beta1.LogPipeline) Result {
+	c := &converter{}
+
+	c.result.Spec.Input = c.convertInput(pipeline.Spec.Input)
+	c.result.Spec.Output = c.convertOutput(pipeline.Spec.Output)
+
+	for i, filter := range pipeline.Spec.FluentBitFilters {
+		c.convertFilter(i, filter)
+	}
+
+	if len(pipeline.Spec.FluentBitVariables) > 0 {
+		c.untranslated("variables", "variables are only used by custom filters and outputs, incorporate them into the transform and filter expressions")
+	}
+
+	if len(pipeline.Spec.FluentBitFiles) > 0 {
+		c.untranslated("files", "files are only used by custom filters and outputs, incorporate them into the transform and filter expressions")
+	}
+
+	return c.result
+}
+
+type converter struct {
+	result Result
+}
+
+func (c *converter) untranslated(field, reason string) {
+	c.result.Untranslated = append(c.result.Untranslated, fmt.Sprintf("%s: %s", field, reason))
+}
+
+func (c *converter) convertInput(input telemetryv1beta1.LogPipelineInput) telemetryv1beta1.LogPipelineInput {
+	result := telemetryv1beta1.LogPipelineInput{
+		// Fluent Bit-based pipelines don't receive OTLP logs, which OpenTelemetry-based pipelines do by default
+		OTLP: &telemetryv1beta1.LogPipelineOTLPInput{OTLPInput: telemetryv1beta1.OTLPInput{Enabled: new(false)}},
+	}
+
+	runtime := input.Runtime
+	if runtime == nil {
+		runtime = &telemetryv1beta1.LogPipelineRuntimeInput{}
+	}
+
+	result.Runtime = &telemetryv1beta1.LogPipelineRuntimeInput{
+		Enabled:          runtime.Enabled,
+		Namespaces:       runtime.Namespaces.DeepCopy(),
+		Containers:       runtime.Containers.DeepCopy(),
+		KeepOriginalBody: runtime.KeepOriginalBody,
+		MinSeverity:      runtime.MinSeverity.DeepCopy(),
+	}
+
+	if runtime.FluentBitKeepAnnotations != nil && *runtime.FluentBitKeepAnnotations {
+		c.untranslated("input.runtime.keepAnnotations", "enrichment with Pod annotations is not supported")
+	}
+
+	if runtime.FluentBitDropLabels != nil && *runtime.FluentBitDropLabels {
+		c.result.Spec.Transforms = append(c.result.Spec.Transforms, telemetryv1beta1.TransformSpec{
+			Statements: []string{`delete_matching_keys(resource.attributes, "^k8s\\.pod\\.label\\..*")`},
+		})
+	} else {
+		c.untranslated("input.runtime.dropLabels", "Pod labels are only added for the keys configured in spec.enrichments.extractPodLabels of the Telemetry resource")
+	}
+
+	return result
+}
+
+func (c *converter) convertOutput(output telemetryv1beta1.LogPipelineOutput) telemetryv1beta1.LogPipelineOutput {
+	if output.FluentBitCustom != "" {
+		c.untranslated("output.custom", "custom outputs cannot be translated, configure an otlp output with the OTLP endpoint of your backend")
+		return telemetryv1beta1.LogPipelineOutput{}
+	}
+
+	if output.FluentBitHTTP == nil {
+		return output
+	}
+
+	httpOutput := output.FluentBitHTTP
+	otlpOutput := &telemetryv1beta1.OTLPOutput{
+		Protocol: telemetryv1beta1.OTLPProtocolHTTP,
+	}
+
+	if httpOutput.Host.ValueFrom != nil {
+		otlpOutput.Endpoint = *httpOutput.Host.DeepCopy()
+
+		c.untranslated("output.http.host", "the host is read from a Secret, which must provide the OTLP endpoint of your backend including scheme and port")
+	} else {
+		otlpOutput.Endpoint = telemetryv1beta1.ValueType{Value: httpEndpoint(httpOutput)}
+
+		c.untranslated("output.http", "the endpoint of the otlp output is derived from the host and port of the http output, replace it with the OTLP endpoint of your backend")
+	}
+
+	if httpOutput.User != nil && httpOutput.Password != nil {
+		otlpOutput.Authentication = &telemetryv1beta1.AuthenticationOptions{
+			Basic: &telemetryv1beta1.BasicAuthOptions{
+				User:     *httpOutput.User.DeepCopy(),
+				Password: *httpOutput.Password.DeepCopy(),
+			},
+		}
+	}
+
+	if httpOutput.TLS != (telemetryv1beta1.OutputTLS{}) {
+		otlpOutput.TLS = httpOutput.TLS.DeepCopy()
+	}
+
+	if httpOutput.URI != "" && httpOutput.URI != defaultHTTPURI {
+		c.untranslated("output.http.uri", "the otlp output uses a predefined index that cannot be changed")
+	}
+
+	if httpOutput.Dedot {
+		c.untranslated("output.http.dedot", "the otlp output keeps the dots in the names of Pod labels")
+	}
+
+	return telemetryv1beta1.LogPipelineOutput{OTLP: otlpOutput}
+}
+
+func httpEndpoint(httpOutput *telemetryv1beta1.FluentBitHTTPOutput) string {
+	scheme := "https"
+	if httpOutput.TLS.Insecure {
+		scheme = "http"
+	}
+
+	port := httpOutput.Port
+	if port == "" {
+		port = defaultHTTPPort
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(httpOutput.Host.Value, port))
+}
@@ -0,0 +1,341 @@
+package fluentbitmigration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestConvertInput(t *testing.T) {
+	tests := []struct {
+		name                 string
+		pipeline             telemetryv1beta1.LogPipeline
+		expectedInput        telemetryv1beta1.LogPipelineInput
+		expectedTransforms   []telemetryv1beta1.TransformSpec
+		expectedUntranslated []string
+	}{
+		{
+			name:     "default runtime input",
+			pipeline: testutils.NewLogPipelineBuilder().WithHTTPOutput().Build(),
+			expectedInput: telemetryv1beta1.LogPipelineInput{
+				Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{},
+				OTLP:    &telemetryv1beta1.LogPipelineOTLPInput{OTLPInput: telemetryv1beta1.OTLPInput{Enabled: new(false)}},
+			},
+			expectedUntranslated: []string{
+				"input.runtime.dropLabels: Pod labels are only added for the keys configured in spec.enrichments.extractPodLabels of the Telemetry resource",
+			},
+		},
+		{
+			name: "namespace and container selectors",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithHTTPOutput().
+				WithIncludeNamespaces("ns1").
+				WithExcludeContainers("istio-proxy").
+				WithKeepOriginalBody(false).
+				WithDropLabels(true).
+				Build(),
+			expectedInput: telemetryv1beta1.LogPipelineInput{
+				Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
+					Namespaces:       &telemetryv1beta1.NamespaceSelector{Include: []string{"ns1"}},
+					Containers:       &telemetryv1beta1.LogPipelineContainerSelector{Exclude: []string{"istio-proxy"}},
+					KeepOriginalBody: new(false),
+				},
+				OTLP: &telemetryv1beta1.LogPipelineOTLPInput{OTLPInput: telemetryv1beta1.OTLPInput{Enabled: new(false)}},
+			},
+			expectedTransforms: []telemetryv1beta1.TransformSpec{
+				{Statements: []string{`delete_matching_keys(resource.attributes, "^k8s\\.pod\\.label\\..*")`}},
+			},
+		},
+		{
+			name: "keep annotations",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithHTTPOutput().
+				WithKeepAnnotations(true).
+				WithDropLabels(true).
+				Build(),
+			expectedInput: telemetryv1beta1.LogPipelineInput{
+				Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{},
+				OTLP:    &telemetryv1beta1.LogPipelineOTLPInput{OTLPInput: telemetryv1beta1.OTLPInput{Enabled: new(false)}},
+			},
+			expectedTransforms: []telemetryv1beta1.TransformSpec{
+				{Statements: []string{`delete_matching_keys(resource.attributes, "^k8s\\.pod\\.label\\..*")`}},
+			},
+			expectedUntranslated: []string{
+				"input.runtime.keepAnnotations: enrichment with Pod annotations is not supported",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Convert(&tt.pipeline)
+
+			require.Equal(t, tt.expectedInput, result.Spec.Input)
+			require.Equal(t, tt.expectedTransforms, result.Spec.Transforms)
+			require.ElementsMatch(t, tt.expectedUntranslated, filterUntranslated(result.Untranslated, "input."))
+		})
+	}
+}
+
+func TestConvertOutput(t *testing.T) {
+	tests := []struct {
+		name                 string
+		output               telemetryv1beta1.LogPipelineOutput
+		expectedOutput       telemetryv1beta1.LogPipelineOutput
+		expectedUntranslated []string
+	}{
+		{
+			name: "http output",
+			output: telemetryv1beta1.LogPipelineOutput{
+				FluentBitHTTP: &telemetryv1beta1.FluentBitHTTPOutput{
+					Host:     telemetryv1beta1.ValueType{Value: "logs.example.com"},
+					Port:     "9200",
+					User:     &telemetryv1beta1.ValueType{Value: "user"},
+					Password: &telemetryv1beta1.ValueType{Value: "password"},
+				},
+			},
+			expectedOutput: telemetryv1beta1.LogPipelineOutput{
+				OTLP: &telemetryv1beta1.OTLPOutput{
+					Protocol: telemetryv1beta1.OTLPProtocolHTTP,
+					Endpoint: telemetryv1beta1.ValueType{Value: "https://logs.example.com:9200"},
+					Authentication: &telemetryv1beta1.AuthenticationOptions{
+						Basic: &telemetryv1beta1.BasicAuthOptions{
+							User:     telemetryv1beta1.ValueType{Value: "user"},
+							Password: telemetryv1beta1.ValueType{Value: "password"},
+						},
+					},
+				},
+			},
+			expectedUntranslated: []string{
+				"output.http: the endpoint of the otlp output is derived from the host and port of the http output, replace it with the OTLP endpoint of your backend",
+			},
+		},
+		{
+			name: "insecure http output with default port, custom uri and dedot",
+			output: telemetryv1beta1.LogPipelineOutput{
+				FluentBitHTTP: &telemetryv1beta1.FluentBitHTTPOutput{
+					Host:  telemetryv1beta1.ValueType{Value: "logs.example.com"},
+					URI:   "/custom",
+					Dedot: true,
+					TLS:   telemetryv1beta1.OutputTLS{Insecure: true},
+				},
+			},
+			expectedOutput: telemetryv1beta1.LogPipelineOutput{
+				OTLP: &telemetryv1beta1.OTLPOutput{
+					Protocol: telemetryv1beta1.OTLPProtocolHTTP,
+					Endpoint: telemetryv1beta1.ValueType{Value: "http://logs.example.com:443"},
+					TLS:      &telemetryv1beta1.OutputTLS{Insecure: true},
+				},
+			},
+			expectedUntranslated: []string{
+				"output.http: the endpoint of the otlp output is derived from the host and port of the http output, replace it with the OTLP endpoint of your backend",
+				"output.http.uri: the otlp output uses a predefined index that cannot be changed",
+				"output.http.dedot: the otlp output keeps the dots in the names of Pod labels",
+			},
+		},
+		{
+			name: "http output with host from secret",
+			output: telemetryv1beta1.LogPipelineOutput{
+				FluentBitHTTP: &telemetryv1beta1.FluentBitHTTPOutput{
+					Host: telemetryv1beta1.ValueType{
+						ValueFrom: &telemetryv1beta1.ValueFromSource{
+							SecretKeyRef: &telemetryv1beta1.SecretKeyRef{Name: "backend", Namespace: "default", Key: "host"},
+						},
+					},
+				},
+			},
+			expectedOutput: telemetryv1beta1.LogPipelineOutput{
+				OTLP: &telemetryv1beta1.OTLPOutput{
+					Protocol: telemetryv1beta1.OTLPProtocolHTTP,
+					Endpoint: telemetryv1beta1.ValueType{
+						ValueFrom: &telemetryv1beta1.ValueFromSource{
+							SecretKeyRef: &telemetryv1beta1.SecretKeyRef{Name: "backend", Namespace: "default", Key: "host"},
+						},
+					},
+				},
+			},
+			expectedUntranslated: []string{
+				"output.http.host: the host is read from a Secret, which must provide the OTLP endpoint of your backend including scheme and port",
+			},
+		},
+		{
+			name: "custom output",
+			output: telemetryv1beta1.LogPipelineOutput{
+				FluentBitCustom: "Name stdout",
+			},
+			expectedOutput: telemetryv1beta1.LogPipelineOutput{},
+			expectedUntranslated: []string{
+				"output.custom: custom outputs cannot be translated, configure an otlp output with the OTLP endpoint of your backend",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewLogPipelineBuilder().WithDropLabels(true).Build()
+			pipeline.Spec.Output = tt.output
+
+			result := Convert(&pipeline)
+
+			require.Equal(t, tt.expectedOutput, result.Spec.Output)
+			require.Equal(t, tt.expectedUntranslated, result.Untranslated)
+		})
+	}
+}
+
+func TestConvertFilters(t *testing.T) {
+	tests := []struct {
+		name                 string
+		filters              []string
+		expectedTransforms   []telemetryv1beta1.TransformSpec
+		expectedFilters      []telemetryv1beta1.FilterSpec
+		expectedUntranslated []string
+	}{
+		{
+			name: "grep filter",
+			filters: []string{`
+Name    grep
+Regex   $kubernetes['namespace_name'] ^prod-.*
+Exclude log health"check`,
+			},
+			expectedFilters: []telemetryv1beta1.FilterSpec{
+				{Conditions: []string{
+					`not IsMatch(resource.attributes["k8s.namespace.name"], "^prod-.*")`,
+					`IsMatch(log.body, "health\"check")`,
+				}},
+			},
+		},
+		{
+			name: "grep filter with regex escapes",
+			filters: []string{`
+Name    grep
+Exclude path ^/healthz/\w+$`,
+			},
+			expectedFilters: []telemetryv1beta1.FilterSpec{
+				{Conditions: []string{`IsMatch(log.attributes["path"], "^/healthz/\\w+$")`}},
+			},
+		},
+		{
+			name: "modify filter",
+			filters: []string{`
+Name   modify
+Set    tenant myTenant
+Add    $kubernetes['labels']['team'] unknown
+Remove password
+Rename level severity
+Hard_copy $kubernetes['pod_name'] pod`,
+			},
+			expectedTransforms: []telemetryv1beta1.TransformSpec{
+				{Statements: []string{
+					`set(log.attributes["tenant"], "myTenant")`,
+					`set(resource.attributes["k8s.pod.label.team"], "unknown") where resource.attributes["k8s.pod.label.team"] == nil`,
+					`delete_key(log.attributes, "password")`,
+					`set(log.attributes["severity"], log.attributes["level"]) where log.attributes["level"] != nil and log.attributes["severity"] == nil`,
+					`delete_key(log.attributes, "level") where log.attributes["severity"] == log.attributes["level"]`,
+					`set(log.attributes["pod"], resource.attributes["k8s.pod.name"]) where resource.attributes["k8s.pod.name"] != nil`,
+				}},
+			},
+		},
+		{
+			name: "record_modifier filter",
+			filters: []string{`
+Name       record_modifier
+Record     cluster my cluster
+Remove_key trace`,
+			},
+			expectedTransforms: []telemetryv1beta1.TransformSpec{
+				{Statements: []string{
+					`set(log.attributes["cluster"], "my cluster")`,
+					`delete_key(log.attributes, "trace")`,
+				}},
+			},
+		},
+		{
+			name: "untranslatable filters",
+			filters: []string{
+				"Name lua\nScript my.lua",
+				"Name grep\nLogical_Op or\nRegex level error",
+				"Name modify\nCondition Key_exists level\nSet tenant myTenant",
+				"Name modify\nRemove log",
+				"Name record_modifier\nRecord $kubernetes['annotations'] foo",
+				"Regex level error",
+			},
+			expectedUntranslated: []string{
+				"filters[0]: filters of type lua cannot be translated",
+				"filters[1]: grep rule logical_op cannot be translated",
+				"filters[2]: modify rule condition cannot be translated",
+				"filters[3]: the log body cannot be removed",
+				"filters[4]: unsupported record key $kubernetes['annotations']",
+				"filters[5]: the filter has no name",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewLogPipelineBuilder().WithHTTPOutput().WithDropLabels(true).Build()
+			for _, filter := range tt.filters {
+				pipeline.Spec.FluentBitFilters = append(pipeline.Spec.FluentBitFilters, telemetryv1beta1.FluentBitFilter{Custom: filter})
+			}
+
+			result := Convert(&pipeline)
+
+			// The first transform drops the Pod labels
+			expectedTransforms := append([]telemetryv1beta1.TransformSpec{
+				{Statements: []string{`delete_matching_keys(resource.attributes, "^k8s\\.pod\\.label\\..*")`}},
+			}, tt.expectedTransforms...)
+			require.Equal(t, expectedTransforms, result.Spec.Transforms)
+			require.Equal(t, tt.expectedFilters, result.Spec.Filters)
+			require.Equal(t, tt.expectedUntranslated, filterUntranslated(result.Untranslated, "filters"))
+		})
+	}
+}
+
+func TestConvertVariablesAndFiles(t *testing.T) {
+	pipeline := testutils.NewLogPipelineBuilder().
+		WithHTTPOutput().
+		WithDropLabels(true).
+		WithVariable("myVar", "secret", "default", "key").
+		WithFile("my.lua", "content").
+		Build()
+
+	result := Convert(&pipeline)
+
+	require.Contains(t, result.Untranslated, "variables: variables are only used by custom filters and outputs, incorporate them into the transform and filter expressions")
+	require.Contains(t, result.Untranslated, "files: files are only used by custom filters and outputs, incorporate them into the transform and filter expressions")
+}
+
+func TestReport(t *testing.T) {
+	result := Result{
+		Spec: telemetryv1beta1.LogPipelineSpec{
+			Filters: []telemetryv1beta1.FilterSpec{{Conditions: []string{`log.body == "x"`}}},
+		},
+		Untranslated: []string{"output.custom: reason"},
+	}
+
+	report, err := result.Report()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"spec": {
+			"input": {},
+			"output": {},
+			"filter": [{"conditions": ["log.body == \"x\""]}]
+		},
+		"untranslated": ["output.custom: reason"]
+	}`, report)
+}
+
+func filterUntranslated(untranslated []string, prefix string) []string {
+	var result []string
+
+	for _, entry := range untranslated {
+		if len(entry) >= len(prefix) && entry[:len(prefix)] == prefix {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
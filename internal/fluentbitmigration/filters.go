@@ -0,0 +1,302 @@
+package fluentbitmigration
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/fluentbit/config"
+)
+
+// kubernetesRecordAccessor matches the record accessors of the metadata added by the Fluent Bit kubernetes filter, like $kubernetes['namespace_name'] or $kubernetes['labels']['app']
+var kubernetesRecordAccessor = regexp.MustCompile(`^\$kubernetes\['([a-z_]+)'\](?:\['([^']+)'\])?$`)
+
+// kubernetesResourceAttributes maps the metadata added by the Fluent Bit kubernetes filter to the resource attributes of OTel logs
+var kubernetesResourceAttributes = map[string]string{
+	"namespace_name": "k8s.namespace.name",
+	"pod_name":       "k8s.pod.name",
+	"container_name": "k8s.container.name",
+	"host":           "k8s.node.name",
+}
+
+var ottlStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+var errUnsupportedKey = errors.New("unsupported record key")
+
+// field is the field of an OTel log record that corresponds to a key of a Fluent Bit record
+type field struct {
+	// parent is the map containing the field, or empty for the log body
+	parent string
+	key    string
+}
+
+func (f field) path() string {
+	if f.parent == "" {
+		return "log.body"
+	}
+
+	return fmt.Sprintf(`%s["%s"]`, f.parent, ottlStringEscaper.Replace(f.key))
+}
+
+func (f field) set(value string) string {
+	return fmt.Sprintf("set(%s, %s)", f.path(), value)
+}
+
+func (f field) delete() (string, error) {
+	if f.parent == "" {
+		return "", errors.New("the log body cannot be removed")
+	}
+
+	return fmt.Sprintf(`delete_key(%s, "%s")`, f.parent, ottlStringEscaper.Replace(f.key)), nil
+}
+
+// fieldFor maps a key of a Fluent Bit record to the field of an OTel log record.
+// The original log line and the parsed message of JSON logs are kept in the body of OTel logs.
+func fieldFor(recordKey string) (field, error) {
+	if matches := kubernetesRecordAccessor.FindStringSubmatch(recordKey); matches != nil {
+		if matches[1] == "labels" && matches[2] != "" {
+			return field{parent: "resource.attributes", key: "k8s.pod.label." + matches[2]}, nil
+		}
+
+		if attribute, found := kubernetesResourceAttributes[matches[1]]; found && matches[2] == "" {
+			return field{parent: "resource.attributes", key: attribute}, nil
+		}
+
+		return field{}, fmt.Errorf("%w %s", errUnsupportedKey, recordKey)
+	}
+
+	key := strings.TrimPrefix(recordKey, "$")
+	if strings.ContainsAny(key, "$[]") || key == "kubernetes" {
+		return field{}, fmt.Errorf("%w %s", errUnsupportedKey, recordKey)
+	}
+
+	switch key {
+	case "log", "message", "msg":
+		return field{}, nil
+	default:
+		return field{parent: "log.attributes", key: key}, nil
+	}
+}
+
+func stringLiteral(value string) string {
+	return fmt.Sprintf(`"%s"`, ottlStringEscaper.Replace(value))
+}
+
+// convertFilter translates a custom Fluent Bit filter of type grep, modify, or record_modifier into an OTTL filter or transform.
+// A filter is either translated completely or reported as untranslated.
+func (c *converter) convertFilter(index int, filter telemetryv1beta1.FluentBitFilter) {
+	fieldName := fmt.Sprintf("filters[%d]", index)
+
+	params, err := config.ParseCustomSection(filter.Custom)
+	if err != nil {
+		c.untranslated(fieldName, err.Error())
+		return
+	}
+
+	name := params.GetByKey("name")
+	if name == nil {
+		c.untranslated(fieldName, "the filter has no name")
+		return
+	}
+
+	var (
+		conditions []string
+		statements []string
+	)
+
+	switch strings.ToLower(name.Value) {
+	case "grep":
+		conditions, err = convertGrepFilter(params)
+	case "modify":
+		statements, err = convertModifyFilter(params)
+	case "record_modifier":
+		statements, err = convertRecordModifierFilter(params)
+	default:
+		err = fmt.Errorf("filters of type %s cannot be translated", name.Value)
+	}
+
+	if err != nil {
+		c.untranslated(fieldName, err.Error())
+		return
+	}
+
+	if len(conditions) > 0 {
+		c.result.Spec.Filters = append(c.result.Spec.Filters, telemetryv1beta1.FilterSpec{Conditions: conditions})
+	}
+
+	if len(statements) > 0 {
+		c.result.Spec.Transforms = append(c.result.Spec.Transforms, telemetryv1beta1.TransformSpec{Statements: statements})
+	}
+}
+
+// convertGrepFilter returns the conditions of the logs dropped by a grep filter.
+// A log is kept if it matches all Regex rules and none of the Exclude rules, so the negated Regex rules and the Exclude rules are ORed.
+func convertGrepFilter(params config.ParameterList) ([]string, error) {
+	var conditions []string
+
+	for _, param := range params {
+		switch param.Key {
+		case "name":
+			continue
+		case "regex", "exclude":
+			recordKey, pattern, err := splitArgs(param)
+			if err != nil {
+				return nil, err
+			}
+
+			f, err := fieldFor(recordKey)
+			if err != nil {
+				return nil, err
+			}
+
+			condition := fmt.Sprintf("IsMatch(%s, %s)", f.path(), stringLiteral(pattern))
+			if param.Key == "regex" {
+				condition = "not " + condition
+			}
+
+			conditions = append(conditions, condition)
+		default:
+			return nil, fmt.Errorf("grep rule %s cannot be translated", param.Key)
+		}
+	}
+
+	return conditions, nil
+}
+
+func convertModifyFilter(params config.ParameterList) ([]string, error) {
+	var statements []string
+
+	for _, param := range params {
+		switch param.Key {
+		case "name":
+			continue
+		case "set", "add":
+			recordKey, value, err := splitArgs(param)
+			if err != nil {
+				return nil, err
+			}
+
+			f, err := fieldFor(recordKey)
+			if err != nil {
+				return nil, err
+			}
+
+			statement := f.set(stringLiteral(value))
+			if param.Key == "add" {
+				statement += fmt.Sprintf(" where %s == nil", f.path())
+			}
+
+			statements = append(statements, statement)
+		case "remove":
+			f, err := fieldFor(param.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			statement, err := f.delete()
+			if err != nil {
+				return nil, err
+			}
+
+			statements = append(statements, statement)
+		case "rename", "hard_rename", "copy", "hard_copy":
+			moveStatements, err := convertMoveRule(param)
+			if err != nil {
+				return nil, err
+			}
+
+			statements = append(statements, moveStatements...)
+		default:
+			return nil, fmt.Errorf("modify rule %s cannot be translated", param.Key)
+		}
+	}
+
+	return statements, nil
+}
+
+// convertMoveRule translates the rules of the modify filter that copy or rename a key. Unless the rule is a hard one, an existing target key is kept.
+func convertMoveRule(param config.Parameter) ([]string, error) {
+	sourceKey, targetKey, err := splitArgs(param)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := fieldFor(sourceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := fieldFor(targetKey)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := fmt.Sprintf("%s != nil", source.path())
+	if !strings.HasPrefix(param.Key, "hard_") {
+		condition += fmt.Sprintf(" and %s == nil", target.path())
+	}
+
+	statements := []string{fmt.Sprintf("%s where %s", target.set(source.path()), condition)}
+
+	if strings.HasSuffix(param.Key, "rename") {
+		deleteStatement, err := source.delete()
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, fmt.Sprintf("%s where %s == %s", deleteStatement, target.path(), source.path()))
+	}
+
+	return statements, nil
+}
+
+func convertRecordModifierFilter(params config.ParameterList) ([]string, error) {
+	var statements []string
+
+	for _, param := range params {
+		switch param.Key {
+		case "name":
+			continue
+		case "record":
+			recordKey, value, err := splitArgs(param)
+			if err != nil {
+				return nil, err
+			}
+
+			f, err := fieldFor(recordKey)
+			if err != nil {
+				return nil, err
+			}
+
+			statements = append(statements, f.set(stringLiteral(value)))
+		case "remove_key":
+			f, err := fieldFor(param.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			statement, err := f.delete()
+			if err != nil {
+				return nil, err
+			}
+
+			statements = append(statements, statement)
+		default:
+			return nil, fmt.Errorf("record_modifier rule %s cannot be translated", param.Key)
+		}
+	}
+
+	return statements, nil
+}
+
+// splitArgs splits the value of a rule into the record key and the remaining argument
+func splitArgs(param config.Parameter) (string, string, error) {
+	key, arg, found := strings.Cut(param.Value, " ")
+	if !found {
+		return "", "", fmt.Errorf("%s rule requires two arguments", param.Key)
+	}
+
+	return key, strings.TrimSpace(arg), nil
+}
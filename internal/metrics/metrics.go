@@ -37,6 +37,7 @@ const (
 
 	FeatureInputPrometheus        = "input-prometheus"
 	FeatureInputIstio             = "input-istio"
+	FeatureInputHost              = "input-host"
 	FeatureOutputDeltaTemporality = "output-delta-temporality"
 
 	// FluentBit features
@@ -60,6 +61,7 @@ var (
 		FeatureInputRuntime,
 		FeatureInputPrometheus,
 		FeatureInputIstio,
+		FeatureInputHost,
 		FeatureOutputDeltaTemporality,
 	}
 
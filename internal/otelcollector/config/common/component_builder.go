@@ -6,6 +6,18 @@ import (
 	"maps"
 	"slices"
 	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+const (
+	// failoverRetryInterval is the interval in which the failover connector tries to send data to the primary output again
+	failoverRetryInterval = "1m"
+	// failoverPrimaryMaxElapsedTime limits the retries of the primary exporter, so that the failover connector switches to the failover output quickly
+	failoverPrimaryMaxElapsedTime = "30s"
 )
 
 // BuildComponentFunc defines a function type for building OpenTelemetry collector components.
@@ -194,6 +206,291 @@ func (cb *ComponentBuilder[T]) AddExporter(componentIDFunc ComponentIDFunc[T], c
 	}
 }
 
+// AddOTLPExporters creates a BuildComponentFunc that adds an OTLP exporter for each output of a pipeline.
+// All exporters are attached to the same service pipeline, so they share its receivers and processors, but each exporter has its own sending queue.
+// If the pipeline has a failover output, the primary output is exported through a failover connector (see addFailoverConnector).
+//
+// Example:
+//
+//	func (b *Builder) addOTLPExporters() BuildComponentFunc[*TracePipeline] {
+//	    return b.AddOTLPExporters(b.Reader, pipelines.TracePipelineOutputs, NewSendingQueue(queueSize))
+//	}
+func (cb *ComponentBuilder[T]) AddOTLPExporters(reader client.Reader, outputsFunc func(pipeline T) []pipelines.OTLPOutput, sendingQueue SendingQueue) BuildComponentFunc[T] {
+	return func(ctx context.Context, pipeline T, pipelineID string) error {
+		outputs := outputsFunc(pipeline)
+		failover := pipelines.FailoverOutput(outputs)
+
+		for _, output := range outputs {
+			var err error
+
+			switch {
+			case output.IsFailover():
+				// The failover output is exported by the failover service pipeline of the primary output
+				continue
+			case output.Name() == "" && failover != nil:
+				err = cb.addFailoverConnector(ctx, reader, pipeline, pipelineID, output, *failover, sendingQueue)
+			default:
+				err = cb.addOTLPExporter(reader, output, sendingQueue, nil)(ctx, pipeline, pipelineID)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// addOTLPExporter creates a BuildComponentFunc that adds an OTLP exporter for the given output.
+// If customize is not nil, it is applied to the exporter configuration before it is added.
+// If the exporter uses a persistent sending queue, the file storage extension backing the queue is added as well.
+func (cb *ComponentBuilder[T]) addOTLPExporter(reader client.Reader, output pipelines.OTLPOutput, sendingQueue SendingQueue, customize func(*OTLPExporterConfig)) BuildComponentFunc[T] {
+	return cb.AddExporter(
+		func(_ T) string {
+			return ComponentIDOTLPExporter(output.OTLP.Protocol, output.Ref)
+		},
+		func(ctx context.Context, _ T) (any, EnvVars, error) {
+			exporterConfig, envVars, err := NewOTLPExporterConfigBuilder(reader, output.OTLP, output.Ref, sendingQueue).OTLPExporter(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if customize != nil {
+				customize(exporterConfig)
+			}
+
+			if exporterConfig.SendingQueue != nil && exporterConfig.SendingQueue.StorageID != "" {
+				cb.AddExtension(exporterConfig.SendingQueue.StorageID, &FileStorageExtensionConfig{
+					CreateDirectory: true,
+					Directory:       sendingQueue.storageDirectory,
+				}, nil)
+			}
+
+			return exporterConfig, envVars, nil
+		},
+	)
+}
+
+// AddKafkaExporters creates a BuildComponentFunc that adds a Kafka exporter for each Kafka output of a pipeline.
+//
+// Example:
+//
+//	func (b *Builder) addKafkaExporters() BuildComponentFunc[*TracePipeline] {
+//	    return b.AddKafkaExporters(b.Reader, pipelines.TracePipelineKafkaOutputs, NewSendingQueue(queueSize))
+//	}
+func (cb *ComponentBuilder[T]) AddKafkaExporters(reader client.Reader, outputsFunc func(pipeline T) []pipelines.KafkaOutput, sendingQueue SendingQueue) BuildComponentFunc[T] {
+	return func(ctx context.Context, pipeline T, pipelineID string) error {
+		for _, output := range outputsFunc(pipeline) {
+			addKafkaExporter := cb.AddExporter(
+				cb.StaticComponentID(ComponentIDKafkaExporter(output.Ref)),
+				func(ctx context.Context, _ T) (any, EnvVars, error) {
+					return NewKafkaExporterConfigBuilder(reader, output.Kafka, output.Ref, sendingQueue).KafkaExporter(ctx)
+				},
+			)
+
+			if err := addKafkaExporter(ctx, pipeline, pipelineID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// AddPrometheusRemoteWriteExporters creates a BuildComponentFunc that adds a Prometheus remote-write exporter for each remote-write output of a pipeline.
+// If an output uses OAuth2 authentication, the OAuth2 client extension of the output is added as well.
+//
+// Example:
+//
+//	func (b *Builder) addPrometheusRemoteWriteExporters() BuildComponentFunc[*MetricPipeline] {
+//	    return b.AddPrometheusRemoteWriteExporters(b.Reader, pipelines.MetricPipelinePrometheusRemoteWriteOutputs, NewSendingQueue(queueSize))
+//	}
+func (cb *ComponentBuilder[T]) AddPrometheusRemoteWriteExporters(reader client.Reader, outputsFunc func(pipeline T) []pipelines.PrometheusRemoteWriteOutput, sendingQueue SendingQueue) BuildComponentFunc[T] {
+	return func(ctx context.Context, pipeline T, pipelineID string) error {
+		for _, output := range outputsFunc(pipeline) {
+			if err := cb.AddOAuth2Extensions(ctx, reader, []pipelines.OTLPOutput{output.OTLPOutput()}); err != nil {
+				return err
+			}
+
+			addPrometheusRemoteWriteExporter := cb.AddExporter(
+				cb.StaticComponentID(ComponentIDPrometheusRemoteWriteExporter(output.Ref)),
+				func(ctx context.Context, _ T) (any, EnvVars, error) {
+					return NewPrometheusRemoteWriteExporterConfigBuilder(reader, output.PrometheusRemoteWrite, output.Ref, sendingQueue).PrometheusRemoteWriteExporter(ctx)
+				},
+			)
+
+			if err := addPrometheusRemoteWriteExporter(ctx, pipeline, pipelineID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// AddElasticsearchExporters creates a BuildComponentFunc that adds an Elasticsearch exporter for each Elasticsearch output of a pipeline.
+// If an output uses OAuth2 authentication, the OAuth2 client extension of the output is added as well.
+//
+// Example:
+//
+//	func (b *Builder) addElasticsearchExporters() BuildComponentFunc[*LogPipeline] {
+//	    return b.AddElasticsearchExporters(b.Reader, pipelines.LogPipelineElasticsearchOutputs, NewSendingQueue(queueSize))
+//	}
+func (cb *ComponentBuilder[T]) AddElasticsearchExporters(reader client.Reader, outputsFunc func(pipeline T) []pipelines.ElasticsearchOutput, sendingQueue SendingQueue) BuildComponentFunc[T] {
+	return func(ctx context.Context, pipeline T, pipelineID string) error {
+		for _, output := range outputsFunc(pipeline) {
+			if err := cb.AddOAuth2Extensions(ctx, reader, []pipelines.OTLPOutput{output.OTLPOutput()}); err != nil {
+				return err
+			}
+
+			addElasticsearchExporter := cb.AddExporter(
+				cb.StaticComponentID(ComponentIDElasticsearchExporter(output.Ref)),
+				func(ctx context.Context, _ T) (any, EnvVars, error) {
+					return NewElasticsearchExporterConfigBuilder(reader, output.Elasticsearch, output.Ref, sendingQueue).ElasticsearchExporter(ctx)
+				},
+			)
+
+			if err := addElasticsearchExporter(ctx, pipeline, pipelineID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// addFailoverConnector adds a failover connector as exporter of the given service pipeline. The connector feeds two additional
+// service pipelines, one exporting to the primary output and one exporting to the failover output.
+// The connector sends data to the failover output while the primary exporter fails and periodically retries the primary output.
+// To let the connector detect failures, the primary exporter has no sending queue and gives up retrying early.
+func (cb *ComponentBuilder[T]) addFailoverConnector(ctx context.Context, reader client.Reader, pipeline T, pipelineID string, primary, failover pipelines.OTLPOutput, sendingQueue SendingQueue) error {
+	connectorID := ComponentIDFailoverConnector(primary.Ref)
+	signal, _, _ := strings.Cut(pipelineID, "/")
+	primaryPipelineID := fmt.Sprintf("%s/%s_primary", signal, primary.Ref.QualifiedName())
+	failoverPipelineID := fmt.Sprintf("%s/%s_failover", signal, primary.Ref.QualifiedName())
+
+	connectorConfig := &FailoverConnectorConfig{
+		PriorityLevels: [][]string{{primaryPipelineID}, {failoverPipelineID}},
+		RetryInterval:  failoverRetryInterval,
+	}
+
+	addConnectorExporter := cb.AddExporter(
+		cb.StaticComponentID(connectorID),
+		func(_ context.Context, _ T) (any, EnvVars, error) {
+			return connectorConfig, nil, nil
+		},
+	)
+	if err := addConnectorExporter(ctx, pipeline, pipelineID); err != nil {
+		return err
+	}
+
+	addConnectorReceiver := cb.AddReceiver(
+		cb.StaticComponentID(connectorID),
+		func(_ T) any {
+			return connectorConfig
+		},
+	)
+
+	if err := cb.AddServicePipeline(ctx, pipeline, primaryPipelineID,
+		addConnectorReceiver,
+		cb.addOTLPExporter(reader, primary, NewSendingQueue(0), func(exporterConfig *OTLPExporterConfig) {
+			exporterConfig.RetryOnFailure.MaxElapsedTime = failoverPrimaryMaxElapsedTime
+		}),
+	); err != nil {
+		return fmt.Errorf("failed to add primary output service pipeline: %w", err)
+	}
+
+	if err := cb.AddServicePipeline(ctx, pipeline, failoverPipelineID,
+		addConnectorReceiver,
+		cb.addOTLPExporter(reader, failover, sendingQueue, nil),
+	); err != nil {
+		return fmt.Errorf("failed to add failover output service pipeline: %w", err)
+	}
+
+	return nil
+}
+
+// AddMetricAggregationServicePipelines adds the service pipelines of a metric pipeline with aggregations.
+// The output service pipeline is built from outputFuncs and passes the metrics to a routing connector. The connector sends the metrics to be aggregated
+// to an aggregation service pipeline, which merges their resources before the aggregation, so that the data points of different resources, like different Pods, are aggregated together.
+// The aggregated metrics and all other metrics are passed to an export service pipeline, which is built from exportFuncs.
+func (cb *ComponentBuilder[T]) AddMetricAggregationServicePipelines(ctx context.Context, pipeline T, pipelineRef pipelines.PipelineRef, aggregations []telemetryv1beta1.MetricAggregation, outputPipelineID string, outputFuncs, exportFuncs []BuildComponentFunc[T]) error {
+	signal, _, _ := strings.Cut(outputPipelineID, "/")
+	aggregationPipelineID := fmt.Sprintf("%s/%s_aggregation", signal, pipelineRef.QualifiedName())
+	exportPipelineID := fmt.Sprintf("%s/%s_export", signal, pipelineRef.QualifiedName())
+
+	routingConnectorID := cb.StaticComponentID(ComponentIDMetricAggregationRoutingConnector(pipelineRef))
+	routingConnectorConfig := MetricAggregationRoutingConnector(aggregations, aggregationPipelineID, exportPipelineID)
+	forwardConnectorID := cb.StaticComponentID(ComponentIDMetricAggregationForwardConnector(pipelineRef))
+
+	if err := cb.AddServicePipeline(ctx, pipeline, outputPipelineID, append(slices.Clone(outputFuncs),
+		cb.AddExporter(routingConnectorID, func(_ context.Context, _ T) (any, EnvVars, error) {
+			return routingConnectorConfig, nil, nil
+		}),
+	)...); err != nil {
+		return err
+	}
+
+	if err := cb.AddServicePipeline(ctx, pipeline, aggregationPipelineID,
+		cb.AddReceiver(routingConnectorID, func(_ T) any {
+			return routingConnectorConfig
+		}),
+		cb.AddProcessor(cb.StaticComponentID(ComponentIDMetricAggregationFlattenProcessor(pipelineRef)), func(_ T) any {
+			return MetricTransformProcessor(MetricAggregationFlattenProcessorStatements())
+		}),
+		cb.AddProcessor(cb.StaticComponentID(ComponentIDMetricAggregationMergeProcessor(pipelineRef)), func(_ T) any {
+			return MetricAggregationMergeProcessor()
+		}),
+		cb.AddProcessor(cb.StaticComponentID(ComponentIDMetricAggregationProcessor(pipelineRef)), func(_ T) any {
+			return MetricTransformProcessor(MetricAggregationProcessorStatements(aggregations))
+		}),
+		cb.AddProcessor(cb.StaticComponentID(ComponentIDMetricAggregationRestoreProcessor(pipelineRef)), func(_ T) any {
+			return MetricAggregationRestoreProcessor()
+		}),
+		cb.AddExporter(forwardConnectorID, func(_ context.Context, _ T) (any, EnvVars, error) {
+			return &ForwardConnectorConfig{}, nil, nil
+		}),
+	); err != nil {
+		return fmt.Errorf("failed to add aggregation service pipeline: %w", err)
+	}
+
+	if err := cb.AddServicePipeline(ctx, pipeline, exportPipelineID, append([]BuildComponentFunc[T]{
+		cb.AddReceiver(routingConnectorID, func(_ T) any {
+			return routingConnectorConfig
+		}),
+		cb.AddReceiver(forwardConnectorID, func(_ T) any {
+			return &ForwardConnectorConfig{}
+		}),
+	}, exportFuncs...)...); err != nil {
+		return fmt.Errorf("failed to add export service pipeline: %w", err)
+	}
+
+	return nil
+}
+
+// AddOAuth2Extensions adds an OAuth2 client extension for each of the given outputs that uses OAuth2 authentication.
+func (cb *ComponentBuilder[T]) AddOAuth2Extensions(ctx context.Context, reader client.Reader, outputs []pipelines.OTLPOutput) error {
+	for _, output := range outputs {
+		if output.OTLP.Authentication == nil || output.OTLP.Authentication.OAuth2 == nil {
+			continue
+		}
+
+		oauth2ExtensionConfig, oauth2ExtensionEnvVars, err := NewOAuth2ExtensionConfigBuilder(
+			reader,
+			output.OTLP.Authentication.OAuth2,
+			output.Ref,
+		).OAuth2Extension(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to build OAuth2 extension for pipeline %s: %w", output.Ref.Name(), err)
+		}
+
+		cb.AddExtension(ComponentIDOAuth2Extension(output.Ref), oauth2ExtensionConfig, oauth2ExtensionEnvVars)
+	}
+
+	return nil
+}
+
 func (cb *ComponentBuilder[T]) AddExtension(componentID string, extensionConfig any, extensionEnvVars EnvVars) {
 	if _, found := cb.Config.Extensions[componentID]; !found {
 		cb.Config.Extensions[componentID] = extensionConfig
@@ -221,5 +518,6 @@ func (cb *ComponentBuilder[T]) StaticComponentID(componentID string) ComponentID
 }
 
 func isConnector(componentID string) bool {
-	return strings.HasPrefix(componentID, "routing") || strings.HasPrefix(componentID, "forward")
+	return strings.HasPrefix(componentID, "routing") || strings.HasPrefix(componentID, "forward") || strings.HasPrefix(componentID, "failover") ||
+		strings.HasPrefix(componentID, "spanmetrics") || strings.HasPrefix(componentID, "servicegraph")
 }
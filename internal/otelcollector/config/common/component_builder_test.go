@@ -55,6 +55,16 @@ func TestComponentBuilder_AddReceiver(t *testing.T) {
 				Endpoint: "localhost:8080",
 			},
 		},
+		{
+			name:        "adds span metrics connector as receiver",
+			componentID: "spanmetrics/test",
+			config: &MockReceiver{
+				Endpoint: "localhost:8080",
+			},
+			expectedConfig: &MockReceiver{
+				Endpoint: "localhost:8080",
+			},
+		},
 		{
 			name:        "skips when config is nil",
 			componentID: "otlp",
@@ -233,6 +243,18 @@ func TestComponentBuilder_AddExporter(t *testing.T) {
 			},
 			expectedEnvs: make(EnvVars),
 		},
+		{
+			name:        "adds service graph connector as exporter",
+			componentID: "servicegraph/test",
+			config: &MockExporter{
+				URL: "http://internal-service:8080",
+			},
+			envVars: make(EnvVars),
+			expectedConfig: &MockExporter{
+				URL: "http://internal-service:8080",
+			},
+			expectedEnvs: make(EnvVars),
+		},
 		{
 			name:        "skips when config is nil",
 			componentID: "otlp_grpc/test",
@@ -17,9 +17,13 @@ const ComponentIDOTLPReceiver ComponentID = "otlp"
 const ComponentIDKymaStatsReceiver ComponentID = "kymastats"
 const ComponentIDK8sClusterReceiver ComponentID = "k8s_cluster"
 const ComponentIDKubeletStatsReceiver ComponentID = "kubelet_stats"
+const ComponentIDHostMetricsReceiver ComponentID = "hostmetrics"
 const ComponentIDPrometheusAppPodsReceiver ComponentID = "prometheus/app-pods"
 const ComponentIDPrometheusAppServicesReceiver ComponentID = "prometheus/app-services"
+const ComponentIDPrometheusAppMonitorsReceiver ComponentID = "prometheus/app-monitors"
 const ComponentIDPrometheusIstioReceiver ComponentID = "prometheus/istio"
+const ComponentIDTraceSamplingReceiver ComponentID = "otlp/trace-sampling"
+const ComponentIDK8sEventsReceiver ComponentID = "k8s_events"
 
 // ComponentIDFileLogReceiver generates a component ID for the file_log receiver specific to a log pipeline.
 // Pipeline name is included in the component ID to keep it unique across pipelines.
@@ -49,11 +53,73 @@ const ComponentIDDropUnknownServiceNameProcessor ComponentID = "transform/drop-u
 const ComponentIDRestoreOtelServiceAttrsProcessor ComponentID = "transform/restore-otel-service-attrs"
 
 const ComponentIDSetKymaInputNameRuntimeProcessor ComponentID = "transform/set-kyma-input-name-runtime"
+const ComponentIDSetKymaInputNameHostProcessor ComponentID = "transform/set-kyma-input-name-host"
 const ComponentIDSetKymaInputNameIstioProcessor ComponentID = "transform/set-kyma-input-name-istio"
 const ComponentIDSetKymaInputNamePrometheusProcessor ComponentID = "transform/set-kyma-input-name-prometheus"
 const ComponentIDSetKymaInputNameKymaProcessor ComponentID = "transform/set-kyma-input-name-kyma"
 const ComponentIDSetKymaInputNameOTLPProcessor ComponentID = "transform/set-kyma-input-name-otlp"
 
+// ComponentIDMinSeverityFilterProcessor generates a component ID for the filter processor dropping the logs with a severity lower than the minimum severity of a pipeline input.
+//
+// Example: filter/logpipeline-min-severity-mypipeline
+func ComponentIDMinSeverityFilterProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("filter/%s-min-severity-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDPodSelectorFilterProcessor generates a component ID for the filter processor dropping the logs of pods not matching the pod selector of a pipeline.
+//
+// Example: filter/logpipeline-pod-selector-mypipeline
+func ComponentIDPodSelectorFilterProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("filter/%s-pod-selector-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDMetricAggregationProcessor generates a component ID for the processor aggregating the metrics of a metric pipeline.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: transform/metricpipeline-aggregation-mypipeline
+func ComponentIDMetricAggregationProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("transform/%s-aggregation-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDMetricAggregationFlattenProcessor generates a component ID for the processor moving the resource attributes of the metrics to be aggregated onto their data points.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: transform/metricpipeline-aggregation-flatten-mypipeline
+func ComponentIDMetricAggregationFlattenProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("transform/%s-aggregation-flatten-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDMetricAggregationMergeProcessor generates a component ID for the processor merging the resources of the metrics to be aggregated.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: groupbyattrs/metricpipeline-aggregation-merge-mypipeline
+func ComponentIDMetricAggregationMergeProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("groupbyattrs/%s-aggregation-merge-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDMetricAggregationRestoreProcessor generates a component ID for the processor moving the remaining resource attributes of the aggregated metrics back to their resources.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: groupbyattrs/metricpipeline-aggregation-restore-mypipeline
+func ComponentIDMetricAggregationRestoreProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("groupbyattrs/%s-aggregation-restore-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDLogDedupProcessor generates a component ID for the processor deduplicating the logs of a log pipeline.
+//
+// Example: logdedup/logpipeline-mypipeline
+func ComponentIDLogDedupProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("logdedup/%s-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDRedactionProcessor generates a component ID for the processor masking sensitive data of a pipeline.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: redaction/tracepipeline-mypipeline
+func ComponentIDRedactionProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("redaction/%s-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
 // ComponentIDUserDefinedFilterProcessor generates a component ID for the user-defined filter processor.
 // Pipeline type and name are included in the component ID to keep it unique across pipelines.
 //
@@ -85,8 +151,24 @@ func ComponentIDNamespaceFilterProcessor(pipelineName string) ComponentID {
 	return fmt.Sprintf("filter/%s-filter-by-namespace", pipelineName)
 }
 
+// ComponentIDElasticsearchIndexProcessor generates a component ID for the transform processor that selects the Elasticsearch index of a log pipeline.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: transform/logpipeline-elasticsearch-index-mypipeline
+func ComponentIDElasticsearchIndexProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("transform/%s-elasticsearch-index-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
 const ComponentIDSetObservedTimeIfZeroProcessor ComponentID = "transform/set-observed-time-if-zero"
 const ComponentIDIstioEnrichmentProcessor ComponentID = "istio_enrichment"
+const ComponentIDK8sEventsResourceAttributesProcessor ComponentID = "transform/k8s-events-resource-attributes"
+
+// ComponentIDK8sEventsFilterProcessor generates a component ID for the filter processor selecting the Kubernetes events of a log pipeline.
+//
+// Example: filter/mypipeline-filter-k8s-events
+func ComponentIDK8sEventsFilterProcessor(pipelineName string) ComponentID {
+	return fmt.Sprintf("filter/%s-filter-k8s-events", pipelineName)
+}
 
 // METRIC-SPECIFIC PROCESSORS =====================================================
 
@@ -118,28 +200,58 @@ const ComponentIDDropRuntimeDaemonSetMetricsProcessor ComponentID = "filter/drop
 const ComponentIDDropRuntimeStatefulSetMetricsProcessor ComponentID = "filter/drop-runtime-statefulset-metrics"
 const ComponentIDDropRuntimeJobMetricsProcessor ComponentID = "filter/drop-runtime-job-metrics"
 const ComponentIDDropRuntimeAdditionalMetricsProcessor ComponentID = "filter/drop-runtime-additional-metrics"
+const ComponentIDDropHostMetricsProcessor ComponentID = "filter/drop-host-metrics"
+const ComponentIDDropHostMountPointsProcessor ComponentID = "filter/drop-host-mount-points"
 const ComponentIDDropPrometheusDiagnosticMetricsProcessor ComponentID = "filter/drop-diagnostic-metrics-if-input-source-prometheus"
 const ComponentIDDropIstioDiagnosticMetricsProcessor ComponentID = "filter/drop-diagnostic-metrics-if-input-source-istio"
+const ComponentIDDropPrometheusMonitorMetricsProcessor ComponentID = "filter/drop-prometheus-monitor-metrics"
 const ComponentIDFilterDropNonPVCVolumesMetricsProcessor ComponentID = "filter/drop-non-pvc-volumes-metrics"
 const ComponentIDFilterDropVirtualNetworkInterfacesProcessor ComponentID = "filter/drop-virtual-network-interfaces"
 const ComponentIDDropServiceNameProcessor ComponentID = "transform/drop-service-name"
+const ComponentIDSetPrometheusMonitorAttributeProcessor ComponentID = "transform/set-prometheus-monitor-attribute"
 const ComponentIDDropSkipEnrichmentAttributeProcessor ComponentID = "transform/drop-skip-enrichment-attribute"
 const ComponentIDSetInstrumentationScopePrometheusProcessor ComponentID = "transform/set-instrumentation-scope-prometheus"
 const ComponentIDSetInstrumentationScopeIstioProcessor ComponentID = "transform/set-instrumentation-scope-istio"
+const ComponentIDSetInstrumentationScopeHostProcessor ComponentID = "transform/set-instrumentation-scope-host"
+const ComponentIDHostMetricsResourceAttributesProcessor ComponentID = "transform/host-metrics-resource-attributes"
 const ComponentIDInsertSkipEnrichmentAttributeProcessor ComponentID = "transform/insert-skip-enrichment-attribute"
 
+// ComponentIDDeltaToCumulativeProcessor generates a component ID for the deltatocumulative processor specific to a metric pipeline.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: deltatocumulative/metricpipeline-mypipeline
+func ComponentIDDeltaToCumulativeProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("deltatocumulative/%s", pipelineRef.QualifiedName())
+}
+
 // TRACE-SPECIFIC PROCESSORS ======================================================
 
 const ComponentIDDropIstioServiceEnrichmentProcessor ComponentID = "transform/drop-istio-service-enrichment"
 
+// ComponentIDHeadSamplingProcessor generates a component ID for the head-based sampling filter processor specific to a trace pipeline.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: filter/tracepipeline-head-sampling-mypipeline
+func ComponentIDHeadSamplingProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("filter/%s-head-sampling-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDTailSamplingProcessor generates a component ID for the tail_sampling processor specific to a trace pipeline.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: tail_sampling/tracepipeline-mypipeline
+func ComponentIDTailSamplingProcessor(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("tail_sampling/%s", pipelineRef.QualifiedName())
+}
+
 // ================================================================================
 // EXPORTERS
 // ================================================================================
 
 // ComponentIDOTLPExporter generates a component ID for the OTLP gRPC or HTTP exporter based on the protocol.
-// Pipeline name is included in the component ID to keep it unique across pipelines.
+// Pipeline name and, for additional outputs, output name are included in the component ID to keep it unique across pipelines and outputs.
 //
-// Example: otlp_grpc/metricpipeline-mypipeline, otlp_http/logpipeline-mypipeline
+// Example: otlp_grpc/metricpipeline-mypipeline, otlp_http/logpipeline-mypipeline, otlp_grpc/tracepipeline-mypipeline_archive
 func ComponentIDOTLPExporter(protocol telemetryv1beta1.OTLPProtocol, pipelineRef pipelines.PipelineRef) ComponentID {
 	if protocol == telemetryv1beta1.OTLPProtocolHTTP {
 		return fmt.Sprintf("otlp_http/%s", pipelineRef.QualifiedName())
@@ -148,6 +260,41 @@ func ComponentIDOTLPExporter(protocol telemetryv1beta1.OTLPProtocol, pipelineRef
 	return fmt.Sprintf("otlp_grpc/%s", pipelineRef.QualifiedName())
 }
 
+// ComponentIDKafkaExporter generates a component ID for the Kafka exporter.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: kafka/tracepipeline-mypipeline
+func ComponentIDKafkaExporter(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("kafka/%s", pipelineRef.QualifiedName())
+}
+
+// ComponentIDPrometheusRemoteWriteExporter generates a component ID for the Prometheus remote-write exporter.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: prometheusremotewrite/metricpipeline-mypipeline
+func ComponentIDPrometheusRemoteWriteExporter(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("prometheusremotewrite/%s", pipelineRef.QualifiedName())
+}
+
+// ComponentIDElasticsearchExporter generates a component ID for the Elasticsearch exporter.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: elasticsearch/logpipeline-mypipeline
+func ComponentIDElasticsearchExporter(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("elasticsearch/%s", pipelineRef.QualifiedName())
+}
+
+// ComponentIDPrometheusExporter is the Prometheus exporter that exposes the metrics of all pipelines with a Prometheus output on one endpoint.
+const ComponentIDPrometheusExporter ComponentID = "prometheus"
+
+// ComponentIDLoadBalancingExporter generates a component ID for the load-balancing exporter.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: loadbalancing/tracepipeline-mypipeline
+func ComponentIDLoadBalancingExporter(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("loadbalancing/%s", pipelineRef.QualifiedName())
+}
+
 // ================================================================================
 // CONNECTORS
 // ================================================================================
@@ -158,13 +305,57 @@ const ComponentIDEnrichmentRoutingConnector ComponentID = "routing/enrichment"
 const ComponentIDRuntimeInputRoutingConnector ComponentID = "routing/runtime-input"
 const ComponentIDPrometheusInputRoutingConnector ComponentID = "routing/prometheus-input"
 const ComponentIDIstioInputRoutingConnector ComponentID = "routing/istio-input"
+const ComponentIDHostInputConnector ComponentID = "forward/host-input"
+const ComponentIDTraceSamplingRoutingConnector ComponentID = "routing/trace-sampling"
+
+// ComponentIDFailoverConnector generates a component ID for the failover connector of the primary output of a pipeline.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: failover/tracepipeline-mypipeline
+func ComponentIDFailoverConnector(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("failover/%s", pipelineRef.QualifiedName())
+}
+
+// ComponentIDMetricAggregationRoutingConnector generates a component ID for the routing connector separating the metrics to be aggregated from the other metrics of a pipeline.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: routing/metricpipeline-aggregation-mypipeline
+func ComponentIDMetricAggregationRoutingConnector(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("routing/%s-aggregation-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDMetricAggregationForwardConnector generates a component ID for the forward connector passing the aggregated metrics of a pipeline to its exporters.
+// Pipeline type and name are included in the component ID to keep it unique across pipelines.
+//
+// Example: forward/metricpipeline-aggregation-mypipeline
+func ComponentIDMetricAggregationForwardConnector(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("forward/%s-aggregation-%s", pipelineRef.TypePrefix(), pipelineRef.Name())
+}
+
+// ComponentIDSpanMetricsConnector generates a component ID for the span metrics connector of a trace pipeline.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: spanmetrics/tracepipeline-mypipeline
+func ComponentIDSpanMetricsConnector(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("spanmetrics/%s", pipelineRef.QualifiedName())
+}
+
+// ComponentIDServiceGraphConnector generates a component ID for the service graph connector of a trace pipeline.
+// Pipeline name is included in the component ID to keep it unique across pipelines.
+//
+// Example: servicegraph/tracepipeline-mypipeline
+func ComponentIDServiceGraphConnector(pipelineRef pipelines.PipelineRef) ComponentID {
+	return fmt.Sprintf("servicegraph/%s", pipelineRef.QualifiedName())
+}
 
 // ================================================================================
 // EXTENSIONS
 // ================================================================================
 
 const ComponentIDK8sLeaderElectorExtension ComponentID = "k8s_leader_elector"
+const ComponentIDK8sEventsLeaderElectorExtension ComponentID = "k8s_leader_elector/k8s-events"
 const ComponentIDFileStorageExtension ComponentID = "file_storage"
+const ComponentIDFileStorageSendingQueueExtension ComponentID = "file_storage/sending-queue"
 const ComponentIDHealthCheckExtension ComponentID = "health_check"
 const ComponentIDPprofExtension ComponentID = "pprof"
 const ComponentIDCGroupRuntimeExtension ComponentID = "cgroup_runtime"
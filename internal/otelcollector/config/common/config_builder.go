@@ -19,6 +19,27 @@ func NewConfig() *Config {
 	return config
 }
 
+// HasSendingQueueStorage returns true if the config stores the sending queues of exporters on disk,
+// which requires a volume mounted at the directory of the file storage extension.
+func HasSendingQueueStorage(config *Config) bool {
+	_, found := config.Extensions[ComponentIDFileStorageSendingQueueExtension]
+	return found
+}
+
+// HasTraceSamplingReceiver returns true if the config receives load-balanced spans for tail sampling,
+// which must be reachable through a dedicated headless Service.
+func HasTraceSamplingReceiver(config *Config) bool {
+	_, found := config.Receivers[ComponentIDTraceSamplingReceiver]
+	return found
+}
+
+// HasPrometheusExporter returns true if the config exposes metrics on the endpoint of the Prometheus exporter,
+// which must be reachable through a dedicated Service.
+func HasPrometheusExporter(config *Config) bool {
+	_, found := config.Exporters[ComponentIDPrometheusExporter]
+	return found
+}
+
 func defaultService() ServiceConfig {
 	telemetry := Telemetry{
 		Metrics: TelemetryMetrics{
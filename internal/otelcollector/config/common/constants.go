@@ -18,6 +18,7 @@ const (
 	InputSourceRuntime    InputSourceType = "runtime"
 	InputSourcePrometheus InputSourceType = "prometheus"
 	InputSourceIstio      InputSourceType = "istio"
+	InputSourceHost       InputSourceType = "host"
 	InputSourceOTLP       InputSourceType = "otlp"
 	InputSourceKyma       InputSourceType = "kyma"
 	InputSourceK8sCluster InputSourceType = "k8s_cluster"
@@ -27,6 +28,7 @@ const (
 	InstrumentationScopeRuntime    = "io.kyma-project.telemetry/runtime"
 	InstrumentationScopePrometheus = "io.kyma-project.telemetry/prometheus"
 	InstrumentationScopeIstio      = "io.kyma-project.telemetry/istio"
+	InstrumentationScopeHost       = "io.kyma-project.telemetry/host"
 	InstrumentationScopeKyma       = "io.kyma-project.telemetry/kyma"
 )
 
@@ -34,6 +36,7 @@ var InstrumentationScope = map[InputSourceType]string{
 	InputSourceRuntime:    InstrumentationScopeRuntime,
 	InputSourcePrometheus: InstrumentationScopePrometheus,
 	InputSourceIstio:      InstrumentationScopeIstio,
+	InputSourceHost:       InstrumentationScopeHost,
 	InputSourceKyma:       InstrumentationScopeKyma,
 	InputSourceK8sCluster: InstrumentationScopeRuntime,
 }
@@ -42,6 +45,7 @@ var InputName = map[InputSourceType]ComponentID{
 	InputSourceRuntime:    ComponentIDSetKymaInputNameRuntimeProcessor,
 	InputSourcePrometheus: ComponentIDSetKymaInputNamePrometheusProcessor,
 	InputSourceIstio:      ComponentIDSetKymaInputNameIstioProcessor,
+	InputSourceHost:       ComponentIDSetKymaInputNameHostProcessor,
 	InputSourceKyma:       ComponentIDSetKymaInputNameKymaProcessor,
 	InputSourceOTLP:       ComponentIDSetKymaInputNameOTLPProcessor,
 }
@@ -65,6 +69,8 @@ const (
 	kymaAppName                        = "kyma.app_name"
 	kymaOtelAnnotationServiceName      = "kyma.otel.annotation.service.name"
 	kymaOtelAnnotationServiceVersion   = "kyma.otel.annotation.service.version"
+	kymaPodSelectorLabelPrefix         = "kyma.pod_selector.label."
+	kymaPodSelectorAnnotationPrefix    = "kyma.pod_selector.annotation."
 	otelAnnotationKeyServiceName       = "resource.opentelemetry.io/service.name"
 	otelAnnotationKeyServiceVersion    = "resource.opentelemetry.io/service.version"
 	defaultTransformProcessorErrorMode = "ignore"
@@ -74,6 +80,7 @@ const (
 const (
 	K8sLeaderElectorKymaStats  = "telemetry-metric-gateway-kymastats"
 	K8sLeaderElectorK8sCluster = "telemetry-metric-agent-k8scluster"
+	K8sLeaderElectorK8sEvents  = "telemetry-otlp-gateway-k8sevents"
 )
 
 const (
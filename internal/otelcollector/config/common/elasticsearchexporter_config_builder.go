@@ -0,0 +1,77 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+// =============================================================================
+// ELASTICSEARCH EXPORTER CONFIG BUILDER
+// =============================================================================
+
+// elasticsearchIndexAttribute is the resource attribute from which the Elasticsearch exporter takes the index of a log (dynamic index routing)
+const elasticsearchIndexAttribute = "elasticsearch.index"
+
+type ElasticsearchExporterConfigBuilder struct {
+	reader       client.Reader
+	esOutput     *telemetryv1beta1.ElasticsearchOutput
+	pipelineRef  pipelines.PipelineRef
+	sendingQueue SendingQueue
+}
+
+func NewElasticsearchExporterConfigBuilder(reader client.Reader, esOutput *telemetryv1beta1.ElasticsearchOutput, pipelineRef pipelines.PipelineRef, sendingQueue SendingQueue) *ElasticsearchExporterConfigBuilder {
+	return &ElasticsearchExporterConfigBuilder{
+		reader:       reader,
+		esOutput:     esOutput,
+		pipelineRef:  pipelineRef,
+		sendingQueue: sendingQueue,
+	}
+}
+
+func (cb *ElasticsearchExporterConfigBuilder) ElasticsearchExporter(ctx context.Context) (*ElasticsearchExporterConfig, EnvVars, error) {
+	// The connection settings are shared with OTLP/HTTP outputs, so the same environment variables are used to resolve them
+	httpOutput := pipelines.ElasticsearchOutput{Ref: cb.pipelineRef, Elasticsearch: cb.esOutput}.OTLPOutput().OTLP
+
+	envVars, err := makeOTLPExporterEnvVars(ctx, cb.reader, httpOutput, cb.pipelineRef)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to make env vars: %w", err)
+	}
+
+	exporter := elasticsearchExporter(cb.esOutput, httpOutput, cb.pipelineRef, envVars, cb.sendingQueue)
+
+	return exporter, envVars, nil
+}
+
+//nolint:mnd // hardcoded values
+func elasticsearchExporter(esOutput *telemetryv1beta1.ElasticsearchOutput, httpOutput *telemetryv1beta1.OTLPOutput, pipelineRef pipelines.PipelineRef, envVars map[string][]byte, sendingQueue SendingQueue) *ElasticsearchExporterConfig {
+	endpointVariable := formatEnvVarKey(otlpEndpointVariablePrefix, pipelineRef)
+	endpointValue := string(envVars[endpointVariable])
+
+	exporter := ElasticsearchExporterConfig{
+		Endpoint:     fmt.Sprintf("${%s}", endpointVariable),
+		LogsIndex:    esOutput.Index,
+		Headers:      headers(httpOutput, pipelineRef),
+		TLS:          tls(httpOutput, endpointValue, pipelineRef),
+		SendingQueue: &sendingQueue,
+		// The Elasticsearch exporter retries failed documents of a bulk request itself instead of using the generic retry_on_failure settings
+		Retry: ElasticsearchRetry{
+			Enabled:         true,
+			InitialInterval: "5s",
+			MaxInterval:     "30s",
+			MaxRetries:      10,
+		},
+	}
+
+	if esOutput.Authentication != nil && esOutput.Authentication.OAuth2 != nil {
+		exporter.Auth = Auth{
+			Authenticator: ComponentIDOAuth2Extension(pipelineRef),
+		}
+	}
+
+	return &exporter
+}
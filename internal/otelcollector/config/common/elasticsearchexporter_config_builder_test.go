@@ -0,0 +1,85 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+func TestElasticsearchExporterID(t *testing.T) {
+	require.Equal(t, "elasticsearch/logpipeline-test", ComponentIDElasticsearchExporter(logRefTest()))
+}
+
+func TestMakeElasticsearchExporterConfig(t *testing.T) {
+	output := &telemetryv1beta1.ElasticsearchOutput{
+		Endpoint: telemetryv1beta1.ValueType{Value: "https://elasticsearch:9200"},
+		Index:    "logs",
+	}
+
+	cb := NewElasticsearchExporterConfigBuilder(fake.NewClientBuilder().Build(), output, logRefTest(), NewSendingQueue(512))
+	exporterConfig, envVars, err := cb.ElasticsearchExporter(t.Context())
+	require.NoError(t, err)
+	require.NotNil(t, envVars)
+
+	require.Equal(t, "${OTLP_ENDPOINT_LOGPIPELINE_TEST}", exporterConfig.Endpoint)
+	require.Equal(t, []byte("https://elasticsearch:9200"), envVars["OTLP_ENDPOINT_LOGPIPELINE_TEST"])
+	require.Equal(t, "logs", exporterConfig.LogsIndex)
+	require.False(t, exporterConfig.TLS.Insecure)
+	require.Empty(t, exporterConfig.Auth.Authenticator)
+
+	require.True(t, exporterConfig.SendingQueue.Enabled)
+	require.Equal(t, 512, exporterConfig.SendingQueue.QueueSize)
+
+	require.True(t, exporterConfig.Retry.Enabled)
+	require.Equal(t, "5s", exporterConfig.Retry.InitialInterval)
+	require.Equal(t, "30s", exporterConfig.Retry.MaxInterval)
+	require.Equal(t, 10, exporterConfig.Retry.MaxRetries)
+}
+
+func TestMakeElasticsearchExporterConfigWithAuthentication(t *testing.T) {
+	output := &telemetryv1beta1.ElasticsearchOutput{
+		Endpoint: telemetryv1beta1.ValueType{Value: "http://elasticsearch:9200"},
+		Authentication: &telemetryv1beta1.AuthenticationOptions{
+			Basic: &telemetryv1beta1.BasicAuthOptions{
+				User:     telemetryv1beta1.ValueType{Value: "user"},
+				Password: telemetryv1beta1.ValueType{Value: "password"},
+			},
+		},
+		TLS: &telemetryv1beta1.OutputTLS{
+			CA: &telemetryv1beta1.ValueType{Value: "ca"},
+		},
+	}
+
+	cb := NewElasticsearchExporterConfigBuilder(fake.NewClientBuilder().Build(), output, logRefTest(), NewSendingQueue(512))
+	exporterConfig, envVars, err := cb.ElasticsearchExporter(t.Context())
+	require.NoError(t, err)
+
+	require.Empty(t, exporterConfig.LogsIndex)
+	require.Equal(t, "${BASIC_AUTH_HEADER_LOGPIPELINE_TEST}", exporterConfig.Headers["Authorization"])
+	require.Contains(t, envVars, "BASIC_AUTH_HEADER_LOGPIPELINE_TEST")
+
+	require.True(t, exporterConfig.TLS.Insecure)
+	require.Equal(t, "${OTLP_TLS_CA_PEM_LOGPIPELINE_TEST}", exporterConfig.TLS.CAPem)
+	require.Equal(t, []byte("ca"), envVars["OTLP_TLS_CA_PEM_LOGPIPELINE_TEST"])
+}
+
+func TestMakeElasticsearchExporterConfigWithOAuth2(t *testing.T) {
+	output := &telemetryv1beta1.ElasticsearchOutput{
+		Endpoint: telemetryv1beta1.ValueType{Value: "https://elasticsearch:9200"},
+		Authentication: &telemetryv1beta1.AuthenticationOptions{
+			OAuth2: &telemetryv1beta1.OAuth2Options{
+				TokenURL:     telemetryv1beta1.ValueType{Value: "https://auth.example.com/token"},
+				ClientID:     telemetryv1beta1.ValueType{Value: "client-id"},
+				ClientSecret: telemetryv1beta1.ValueType{Value: "client-secret"},
+			},
+		},
+	}
+
+	cb := NewElasticsearchExporterConfigBuilder(fake.NewClientBuilder().Build(), output, logRefTest(), NewSendingQueue(512))
+	exporterConfig, _, err := cb.ElasticsearchExporter(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "oauth2client/logpipeline-test", exporterConfig.Auth.Authenticator)
+}
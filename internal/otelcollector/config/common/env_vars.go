@@ -25,6 +25,8 @@ const (
 	oauth2TokenURLVariablePrefix     = "OAUTH2_TOKEN_URL"     //nolint:gosec // G101: This is a variable name prefix, not a credential
 	oauth2ClientIDVariablePrefix     = "OAUTH2_CLIENT_ID"     //nolint:gosec // G101: This is a variable name prefix, not a credential
 	oauth2ClientSecretVariablePrefix = "OAUTH2_CLIENT_SECRET" //nolint:gosec // G101: This is a variable name prefix, not a credential
+	kafkaSASLUsernameVariablePrefix  = "KAFKA_SASL_USERNAME"
+	kafkaSASLPasswordVariablePrefix  = "KAFKA_SASL_PASSWORD" //nolint:gosec // G101: This is a variable name prefix, not a credential
 )
 
 // =============================================================================
@@ -51,7 +53,25 @@ func makeOTLPExporterEnvVars(ctx context.Context, c client.Reader, output *telem
 		return nil, err
 	}
 
-	err = makeTLSEnvVar(ctx, c, secretData, output, pipelineRef)
+	err = makeTLSEnvVar(ctx, c, secretData, output.TLS, pipelineRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return secretData, nil
+}
+
+func makeKafkaExporterEnvVars(ctx context.Context, c client.Reader, output *telemetryv1beta1.KafkaOutput, pipelineRef pipelines.PipelineRef) (map[string][]byte, error) {
+	var err error
+
+	secretData := make(map[string][]byte)
+
+	err = makeKafkaSASLEnvVars(ctx, c, secretData, output, pipelineRef)
+	if err != nil {
+		return nil, err
+	}
+
+	err = makeTLSEnvVar(ctx, c, secretData, output.TLS, pipelineRef)
 	if err != nil {
 		return nil, err
 	}
@@ -130,10 +150,10 @@ func makeHeaderEnvVar(ctx context.Context, c client.Reader, secretData map[strin
 	return nil
 }
 
-func makeTLSEnvVar(ctx context.Context, c client.Reader, secretData map[string][]byte, output *telemetryv1beta1.OTLPOutput, pipelineRef pipelines.PipelineRef) error {
-	if output.TLS != nil {
-		if sharedtypesutils.IsValid(output.TLS.CA) {
-			ca, err := sharedtypesutils.ResolveValue(ctx, c, *output.TLS.CA)
+func makeTLSEnvVar(ctx context.Context, c client.Reader, secretData map[string][]byte, tlsOptions *telemetryv1beta1.OutputTLS, pipelineRef pipelines.PipelineRef) error {
+	if tlsOptions != nil {
+		if sharedtypesutils.IsValid(tlsOptions.CA) {
+			ca, err := sharedtypesutils.ResolveValue(ctx, c, *tlsOptions.CA)
 			if err != nil {
 				return err
 			}
@@ -142,13 +162,13 @@ func makeTLSEnvVar(ctx context.Context, c client.Reader, secretData map[string][
 			secretData[tlsConfigCaVariable] = ca
 		}
 
-		if sharedtypesutils.IsValid(output.TLS.Cert) && sharedtypesutils.IsValid(output.TLS.Key) {
-			cert, err := sharedtypesutils.ResolveValue(ctx, c, *output.TLS.Cert)
+		if sharedtypesutils.IsValid(tlsOptions.Cert) && sharedtypesutils.IsValid(tlsOptions.Key) {
+			cert, err := sharedtypesutils.ResolveValue(ctx, c, *tlsOptions.Cert)
 			if err != nil {
 				return err
 			}
 
-			key, err := sharedtypesutils.ResolveValue(ctx, c, *output.TLS.Key)
+			key, err := sharedtypesutils.ResolveValue(ctx, c, *tlsOptions.Key)
 			if err != nil {
 				return err
 			}
@@ -168,6 +188,27 @@ func makeTLSEnvVar(ctx context.Context, c client.Reader, secretData map[string][
 	return nil
 }
 
+func makeKafkaSASLEnvVars(ctx context.Context, c client.Reader, secretData map[string][]byte, output *telemetryv1beta1.KafkaOutput, pipelineRef pipelines.PipelineRef) error {
+	if !isKafkaSASLEnabled(output.Authentication) {
+		return nil
+	}
+
+	username, err := sharedtypesutils.ResolveValue(ctx, c, output.Authentication.SASL.User)
+	if err != nil {
+		return err
+	}
+
+	password, err := sharedtypesutils.ResolveValue(ctx, c, output.Authentication.SASL.Password)
+	if err != nil {
+		return err
+	}
+
+	secretData[formatEnvVarKey(kafkaSASLUsernameVariablePrefix, pipelineRef)] = username
+	secretData[formatEnvVarKey(kafkaSASLPasswordVariablePrefix, pipelineRef)] = password
+
+	return nil
+}
+
 func makeTokenURLEnvVar(ctx context.Context, c client.Reader, secretData map[string][]byte, oauth2Options *telemetryv1beta1.OAuth2Options, pipelineRef pipelines.PipelineRef) error {
 	if oauth2Options != nil && sharedtypesutils.IsValid(&oauth2Options.TokenURL) {
 		tokenURL, err := sharedtypesutils.ResolveValue(ctx, c, oauth2Options.TokenURL)
@@ -256,20 +297,31 @@ func formatBasicAuthHeader(username string, password string) string {
 // Example: Type="trace" → "PREFIX_TRACEPIPELINE_PIPELINENAME"
 func formatEnvVarKey(prefix string, pipelineRef pipelines.PipelineRef) string {
 	if tp := pipelineRef.TypePrefix(); tp != "" {
-		return fmt.Sprintf("%s_%s_%s", prefix, sanitizeEnvVarName(tp), sanitizeEnvVarName(pipelineRef.Name()))
+		return fmt.Sprintf("%s_%s_%s", prefix, sanitizeEnvVarName(tp), envVarPipelineName(pipelineRef))
 	}
 
-	return fmt.Sprintf("%s_%s", prefix, sanitizeEnvVarName(pipelineRef.Name()))
+	return fmt.Sprintf("%s_%s", prefix, envVarPipelineName(pipelineRef))
 }
 
 // formatHeaderEnvVarKey builds an environment variable key for a custom header.
 // Example: signalType="trace" → "HEADER_TRACEPIPELINE_PIPELINENAME_HEADERNAME"
 func formatHeaderEnvVarKey(header telemetryv1beta1.Header, pipelineRef pipelines.PipelineRef) string {
 	if tp := pipelineRef.TypePrefix(); tp != "" {
-		return fmt.Sprintf("HEADER_%s_%s_%s", sanitizeEnvVarName(tp), sanitizeEnvVarName(pipelineRef.Name()), sanitizeEnvVarName(header.Name))
+		return fmt.Sprintf("HEADER_%s_%s_%s", sanitizeEnvVarName(tp), envVarPipelineName(pipelineRef), sanitizeEnvVarName(header.Name))
+	}
+
+	return fmt.Sprintf("HEADER_%s_%s", envVarPipelineName(pipelineRef), sanitizeEnvVarName(header.Name))
+}
+
+// envVarPipelineName returns the sanitized pipeline name. For additional outputs, the output name is appended with a double underscore.
+// Example: name="my-pipeline", outputName="archive" → "MY_PIPELINE__ARCHIVE"
+func envVarPipelineName(pipelineRef pipelines.PipelineRef) string {
+	name := sanitizeEnvVarName(pipelineRef.Name())
+	if outputName := pipelineRef.OutputName(); outputName != "" {
+		name += "__" + sanitizeEnvVarName(outputName)
 	}
 
-	return fmt.Sprintf("HEADER_%s_%s", sanitizeEnvVarName(pipelineRef.Name()), sanitizeEnvVarName(header.Name))
+	return name
 }
 
 func sanitizeEnvVarName(input string) string {
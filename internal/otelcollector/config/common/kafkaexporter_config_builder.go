@@ -0,0 +1,125 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
+)
+
+// =============================================================================
+// KAFKA EXPORTER CONFIG BUILDER
+// =============================================================================
+
+type KafkaExporterConfigBuilder struct {
+	reader       client.Reader
+	kafkaOutput  *telemetryv1beta1.KafkaOutput
+	pipelineRef  pipelines.PipelineRef
+	sendingQueue SendingQueue
+}
+
+func NewKafkaExporterConfigBuilder(reader client.Reader, kafkaOutput *telemetryv1beta1.KafkaOutput, pipelineRef pipelines.PipelineRef, sendingQueue SendingQueue) *KafkaExporterConfigBuilder {
+	return &KafkaExporterConfigBuilder{
+		reader:       reader,
+		kafkaOutput:  kafkaOutput,
+		pipelineRef:  pipelineRef,
+		sendingQueue: sendingQueue,
+	}
+}
+
+func (cb *KafkaExporterConfigBuilder) KafkaExporter(ctx context.Context) (*KafkaExporterConfig, EnvVars, error) {
+	envVars, err := makeKafkaExporterEnvVars(ctx, cb.reader, cb.kafkaOutput, cb.pipelineRef)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to make env vars: %w", err)
+	}
+
+	exporter := kafkaExporter(cb.kafkaOutput, cb.pipelineRef, cb.sendingQueue)
+
+	return exporter, envVars, nil
+}
+
+func kafkaExporter(kafkaOutput *telemetryv1beta1.KafkaOutput, pipelineRef pipelines.PipelineRef, sendingQueue SendingQueue) *KafkaExporterConfig {
+	encoding := string(kafkaOutput.Encoding)
+	if encoding == "" {
+		encoding = string(telemetryv1beta1.KafkaEncodingOTLPProto)
+	}
+
+	signalConfig := &KafkaSignalConfig{
+		Topic:    kafkaOutput.Topic,
+		Encoding: encoding,
+	}
+
+	exporter := KafkaExporterConfig{
+		Brokers:      kafkaOutput.Brokers,
+		Auth:         kafkaAuth(kafkaOutput, pipelineRef),
+		TLS:          kafkaTLS(kafkaOutput, pipelineRef),
+		SendingQueue: &sendingQueue,
+		RetryOnFailure: RetryOnFailure{
+			Enabled:         true,
+			InitialInterval: "5s",
+			MaxInterval:     "30s",
+			MaxElapsedTime:  "300s",
+		},
+	}
+
+	partitionByTraceID := kafkaOutput.PartitionBy == telemetryv1beta1.KafkaPartitionByTraceID
+	partitionByResourceAttributes := kafkaOutput.PartitionBy == telemetryv1beta1.KafkaPartitionByResourceAttributes
+
+	switch pipelineRef.SignalType() {
+	case pipelines.SignalTypeTrace:
+		exporter.Traces = signalConfig
+		exporter.PartitionTracesByID = partitionByTraceID
+	case pipelines.SignalTypeMetric:
+		exporter.Metrics = signalConfig
+		exporter.PartitionMetricsByResourceAttributes = partitionByResourceAttributes
+	case pipelines.SignalTypeLog:
+		exporter.Logs = signalConfig
+		exporter.PartitionLogsByTraceID = partitionByTraceID
+		exporter.PartitionLogsByResourceAttributes = partitionByResourceAttributes
+	}
+
+	return &exporter
+}
+
+// kafkaTLS returns the TLS configuration of the Kafka exporter, or nil if the connection to the brokers is not encrypted.
+func kafkaTLS(output *telemetryv1beta1.KafkaOutput, pipelineRef pipelines.PipelineRef) *TLS {
+	if output.TLS == nil || output.TLS.Insecure {
+		return nil
+	}
+
+	var tls TLS
+
+	setTLSOptions(&tls, output.TLS, pipelineRef)
+
+	return &tls
+}
+
+func kafkaAuth(output *telemetryv1beta1.KafkaOutput, pipelineRef pipelines.PipelineRef) *KafkaAuth {
+	if !isKafkaSASLEnabled(output.Authentication) {
+		return nil
+	}
+
+	mechanism := string(output.Authentication.SASL.Mechanism)
+	if mechanism == "" {
+		mechanism = string(telemetryv1beta1.KafkaSASLMechanismSCRAMSHA512)
+	}
+
+	return &KafkaAuth{
+		SASL: &KafkaSASL{
+			Username:  fmt.Sprintf("${%s}", formatEnvVarKey(kafkaSASLUsernameVariablePrefix, pipelineRef)),
+			Password:  fmt.Sprintf("${%s}", formatEnvVarKey(kafkaSASLPasswordVariablePrefix, pipelineRef)),
+			Mechanism: mechanism,
+		},
+	}
+}
+
+func isKafkaSASLEnabled(authOptions *telemetryv1beta1.KafkaAuthenticationOptions) bool {
+	return authOptions != nil &&
+		authOptions.SASL != nil &&
+		sharedtypesutils.IsValid(&authOptions.SASL.User) &&
+		sharedtypesutils.IsValid(&authOptions.SASL.Password)
+}
@@ -0,0 +1,156 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+func logRefTest() pipelines.PipelineRef {
+	return pipelines.LogPipelineRef(&telemetryv1beta1.LogPipeline{ObjectMeta: metav1.ObjectMeta{Name: "test"}})
+}
+
+func TestKafkaExporterID(t *testing.T) {
+	require.Equal(t, "kafka/tracepipeline-test", ComponentIDKafkaExporter(traceRefTest()))
+}
+
+func TestMakeKafkaExporterConfig(t *testing.T) {
+	output := &telemetryv1beta1.KafkaOutput{
+		Brokers: []string{"kafka-0:9092", "kafka-1:9092"},
+		Topic:   "traces",
+	}
+
+	cb := NewKafkaExporterConfigBuilder(fake.NewClientBuilder().Build(), output, traceRefTest(), NewSendingQueue(512))
+	kafkaExporterConfig, envVars, err := cb.KafkaExporter(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, envVars)
+
+	require.Equal(t, []string{"kafka-0:9092", "kafka-1:9092"}, kafkaExporterConfig.Brokers)
+	require.Equal(t, &KafkaSignalConfig{Topic: "traces", Encoding: "otlp_proto"}, kafkaExporterConfig.Traces)
+	require.Nil(t, kafkaExporterConfig.Metrics)
+	require.Nil(t, kafkaExporterConfig.Logs)
+	require.Nil(t, kafkaExporterConfig.Auth)
+	require.Nil(t, kafkaExporterConfig.TLS)
+
+	require.True(t, kafkaExporterConfig.SendingQueue.Enabled)
+	require.Equal(t, 512, kafkaExporterConfig.SendingQueue.QueueSize)
+
+	require.True(t, kafkaExporterConfig.RetryOnFailure.Enabled)
+	require.Equal(t, "5s", kafkaExporterConfig.RetryOnFailure.InitialInterval)
+	require.Equal(t, "30s", kafkaExporterConfig.RetryOnFailure.MaxInterval)
+	require.Equal(t, "300s", kafkaExporterConfig.RetryOnFailure.MaxElapsedTime)
+}
+
+func TestMakeKafkaExporterConfigPartitioning(t *testing.T) {
+	tests := []struct {
+		name                                    string
+		ref                                     pipelines.PipelineRef
+		partitionBy                             telemetryv1beta1.KafkaPartitionKey
+		expectedPartitionTracesByID             bool
+		expectedPartitionMetricsByResourceAttrs bool
+		expectedPartitionLogsByTraceID          bool
+		expectedPartitionLogsByResourceAttrs    bool
+	}{
+		{
+			name:                        "traces by trace ID",
+			ref:                         traceRefTest(),
+			partitionBy:                 telemetryv1beta1.KafkaPartitionByTraceID,
+			expectedPartitionTracesByID: true,
+		},
+		{
+			name:                                    "metrics by resource attributes",
+			ref:                                     metricRefTest(),
+			partitionBy:                             telemetryv1beta1.KafkaPartitionByResourceAttributes,
+			expectedPartitionMetricsByResourceAttrs: true,
+		},
+		{
+			name:                           "logs by trace ID",
+			ref:                            logRefTest(),
+			partitionBy:                    telemetryv1beta1.KafkaPartitionByTraceID,
+			expectedPartitionLogsByTraceID: true,
+		},
+		{
+			name:                                 "logs by resource attributes",
+			ref:                                  logRefTest(),
+			partitionBy:                          telemetryv1beta1.KafkaPartitionByResourceAttributes,
+			expectedPartitionLogsByResourceAttrs: true,
+		},
+		{
+			name: "no partitioning",
+			ref:  logRefTest(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := &telemetryv1beta1.KafkaOutput{
+				Brokers:     []string{"kafka:9092"},
+				Topic:       "telemetry",
+				PartitionBy: tt.partitionBy,
+			}
+
+			cb := NewKafkaExporterConfigBuilder(fake.NewClientBuilder().Build(), output, tt.ref, NewSendingQueue(512))
+			kafkaExporterConfig, _, err := cb.KafkaExporter(t.Context())
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expectedPartitionTracesByID, kafkaExporterConfig.PartitionTracesByID)
+			require.Equal(t, tt.expectedPartitionMetricsByResourceAttrs, kafkaExporterConfig.PartitionMetricsByResourceAttributes)
+			require.Equal(t, tt.expectedPartitionLogsByTraceID, kafkaExporterConfig.PartitionLogsByTraceID)
+			require.Equal(t, tt.expectedPartitionLogsByResourceAttrs, kafkaExporterConfig.PartitionLogsByResourceAttributes)
+		})
+	}
+}
+
+func TestMakeKafkaExporterConfigWithSASLAndTLS(t *testing.T) {
+	output := &telemetryv1beta1.KafkaOutput{
+		Brokers:  []string{"kafka:9093"},
+		Topic:    "logs",
+		Encoding: telemetryv1beta1.KafkaEncodingOTLPJSON,
+		Authentication: &telemetryv1beta1.KafkaAuthenticationOptions{
+			SASL: &telemetryv1beta1.KafkaSASLOptions{
+				User:     telemetryv1beta1.ValueType{Value: "user"},
+				Password: telemetryv1beta1.ValueType{Value: "password"},
+			},
+		},
+		TLS: &telemetryv1beta1.OutputTLS{
+			CA: &telemetryv1beta1.ValueType{Value: "ca"},
+		},
+	}
+
+	cb := NewKafkaExporterConfigBuilder(fake.NewClientBuilder().Build(), output, logRefTest(), NewSendingQueue(512))
+	kafkaExporterConfig, envVars, err := cb.KafkaExporter(t.Context())
+	require.NoError(t, err)
+
+	require.Equal(t, &KafkaSignalConfig{Topic: "logs", Encoding: "otlp_json"}, kafkaExporterConfig.Logs)
+
+	require.NotNil(t, kafkaExporterConfig.Auth)
+	require.Equal(t, &KafkaSASL{
+		Username:  "${KAFKA_SASL_USERNAME_LOGPIPELINE_TEST}",
+		Password:  "${KAFKA_SASL_PASSWORD_LOGPIPELINE_TEST}",
+		Mechanism: "SCRAM-SHA-512",
+	}, kafkaExporterConfig.Auth.SASL)
+	require.Equal(t, []byte("user"), envVars["KAFKA_SASL_USERNAME_LOGPIPELINE_TEST"])
+	require.Equal(t, []byte("password"), envVars["KAFKA_SASL_PASSWORD_LOGPIPELINE_TEST"])
+
+	require.NotNil(t, kafkaExporterConfig.TLS)
+	require.Equal(t, "${OTLP_TLS_CA_PEM_LOGPIPELINE_TEST}", kafkaExporterConfig.TLS.CAPem)
+	require.Equal(t, []byte("ca"), envVars["OTLP_TLS_CA_PEM_LOGPIPELINE_TEST"])
+}
+
+func TestMakeKafkaExporterConfigWithInsecureTLS(t *testing.T) {
+	output := &telemetryv1beta1.KafkaOutput{
+		Brokers: []string{"kafka:9092"},
+		Topic:   "metrics",
+		TLS:     &telemetryv1beta1.OutputTLS{Insecure: true},
+	}
+
+	cb := NewKafkaExporterConfigBuilder(fake.NewClientBuilder().Build(), output, metricRefTest(), NewSendingQueue(512))
+	kafkaExporterConfig, _, err := cb.KafkaExporter(t.Context())
+	require.NoError(t, err)
+	require.Nil(t, kafkaExporterConfig.TLS)
+}
@@ -32,6 +32,14 @@ func WithBatch(batch Batch) SendingQueueOption {
 	}
 }
 
+// WithPersistentStorage enables persistent sending queues for outputs that request them (see OTLPOutput.PersistentQueue).
+// The queues are stored by a file storage extension in the given directory, which must be backed by a volume.
+func WithPersistentStorage(directory string) SendingQueueOption {
+	return func(sq *SendingQueue) {
+		sq.storageDirectory = directory
+	}
+}
+
 func NewSendingQueue(queueSize int, opts ...SendingQueueOption) SendingQueue {
 	if queueSize == 0 {
 		return SendingQueue{Enabled: false}
@@ -81,6 +89,10 @@ func otlpExporter(otlpOutput *telemetryv1beta1.OTLPOutput, pipelineRef pipelines
 	otlpEndpointVariable := formatEnvVarKey(otlpEndpointVariablePrefix, pipelineRef)
 	otlpEndpointValue := string(envVars[otlpEndpointVariable])
 
+	if sendingQueue.Enabled && sendingQueue.storageDirectory != "" && pipelines.PersistentQueueEnabled(otlpOutput) {
+		sendingQueue.StorageID = ComponentIDFileStorageSendingQueueExtension
+	}
+
 	compression := string(otlpOutput.Compression)
 	if compression == "" {
 		compression = string(telemetryv1beta1.OTLPCompressionGzip)
@@ -91,7 +103,7 @@ func otlpExporter(otlpOutput *telemetryv1beta1.OTLPOutput, pipelineRef pipelines
 		Headers:      headers(otlpOutput, pipelineRef),
 		TLS:          tls(otlpOutput, otlpEndpointValue, pipelineRef),
 		Compression:  compression,
-		SendingQueue: sendingQueue,
+		SendingQueue: &sendingQueue,
 		RetryOnFailure: RetryOnFailure{
 			Enabled:         true,
 			InitialInterval: "5s",
@@ -137,20 +149,26 @@ func tls(output *telemetryv1beta1.OTLPOutput, otlpEndpointValue string, pipeline
 		tls.Insecure = output.TLS.Insecure
 	}
 
-	tls.InsecureSkipVerify = output.TLS.InsecureSkipVerify
-	if sharedtypesutils.IsValid(output.TLS.CA) {
+	setTLSOptions(&tls, output.TLS, pipelineRef)
+
+	return tls
+}
+
+// setTLSOptions sets the server certificate verification and the certificates of the given output TLS options.
+// The certificates are referenced by the environment variables created by makeTLSEnvVar.
+func setTLSOptions(tls *TLS, tlsOptions *telemetryv1beta1.OutputTLS, pipelineRef pipelines.PipelineRef) {
+	tls.InsecureSkipVerify = tlsOptions.InsecureSkipVerify
+	if sharedtypesutils.IsValid(tlsOptions.CA) {
 		tls.CAPem = fmt.Sprintf("${%s}", formatEnvVarKey(tlsConfigCaVariablePrefix, pipelineRef))
 	}
 
-	if sharedtypesutils.IsValid(output.TLS.Cert) {
+	if sharedtypesutils.IsValid(tlsOptions.Cert) {
 		tls.CertPem = fmt.Sprintf("${%s}", formatEnvVarKey(tlsConfigCertVariablePrefix, pipelineRef))
 	}
 
-	if sharedtypesutils.IsValid(output.TLS.Key) {
+	if sharedtypesutils.IsValid(tlsOptions.Key) {
 		tls.KeyPem = fmt.Sprintf("${%s}", formatEnvVarKey(tlsConfigKeyVariablePrefix, pipelineRef))
 	}
-
-	return tls
 }
 
 func headers(output *telemetryv1beta1.OTLPOutput, pipelineRef pipelines.PipelineRef) map[string]string {
@@ -93,6 +93,22 @@ func TestMakeExporterConfigMetricWithPath(t *testing.T) {
 	require.Empty(t, otlpExporterConfig.Endpoint)
 }
 
+func TestMakeExporterConfigLokiOutput(t *testing.T) {
+	output := pipelines.LokiOutput{
+		Ref:  logRefTest(),
+		Loki: &telemetryv1beta1.LokiOutput{Endpoint: telemetryv1beta1.ValueType{Value: "http://loki:3100"}},
+	}.OTLPOutput()
+
+	cb := NewOTLPExporterConfigBuilder(fake.NewClientBuilder().Build(), output.OTLP, output.Ref, NewSendingQueue(512))
+	otlpExporterConfig, envVars, err := cb.OTLPExporter(t.Context())
+	require.NoError(t, err)
+
+	require.Equal(t, []byte("http://loki:3100/otlp/v1/logs"), envVars["OTLP_ENDPOINT_LOGPIPELINE_TEST"])
+	require.Equal(t, "${OTLP_ENDPOINT_LOGPIPELINE_TEST}", otlpExporterConfig.LogsEndpoint)
+	require.Empty(t, otlpExporterConfig.Endpoint)
+	require.True(t, otlpExporterConfig.TLS.Insecure)
+}
+
 func TestMakeExporterConfigWithBasicAuth(t *testing.T) {
 	output := &telemetryv1beta1.OTLPOutput{
 		Endpoint: telemetryv1beta1.ValueType{Value: "otlp-endpoint"},
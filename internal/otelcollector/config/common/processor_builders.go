@@ -2,6 +2,11 @@ package common
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
@@ -137,6 +142,40 @@ func extractPodLabels(enrichments *operatorv1beta1.EnrichmentSpec) []ExtractLabe
 	return extractPodLabels
 }
 
+// ExtractPodSelectorMetadata returns the extraction config for the pod labels and annotations referenced by the given pod selectors.
+// The values are stored in temporary kyma.* attributes, so that they are not exported unless enriched on purpose.
+func ExtractPodSelectorMetadata(selectors []*telemetryv1beta1.LogPipelinePodSelector) (labels []ExtractLabel, annotations []ExtractLabel) {
+	var labelKeys, annotationKeys []string
+
+	for _, selector := range selectors {
+		if selector == nil {
+			continue
+		}
+
+		if selector.Labels != nil {
+			labelKeys = append(labelKeys, slices.Collect(maps.Keys(selector.Labels.MatchLabels))...)
+			for _, expr := range selector.Labels.MatchExpressions {
+				labelKeys = append(labelKeys, expr.Key)
+			}
+		}
+
+		annotationKeys = append(annotationKeys, slices.Collect(maps.Keys(selector.Annotations))...)
+	}
+
+	slices.Sort(labelKeys)
+	slices.Sort(annotationKeys)
+
+	for _, key := range slices.Compact(labelKeys) {
+		labels = append(labels, ExtractLabel{From: "pod", Key: key, TagName: kymaPodSelectorLabelPrefix + key})
+	}
+
+	for _, key := range slices.Compact(annotationKeys) {
+		annotations = append(annotations, ExtractLabel{From: "pod", Key: key, TagName: kymaPodSelectorAnnotationPrefix + key})
+	}
+
+	return labels, annotations
+}
+
 // =============================================================================
 // RESOURCE PROCESSOR BUILDERS
 // =============================================================================
@@ -151,10 +190,150 @@ func ResolveServiceName() *ServiceEnrichmentProcessorConfig {
 	}
 }
 
+// RedactionDetectorPatterns maps the built-in detectors of sensitive data to the regular expressions (RE2 syntax) matching the data.
+var RedactionDetectorPatterns = map[telemetryv1beta1.RedactionDetector]string{
+	// Visa, Mastercard, American Express, and Discover card numbers, optionally grouped with spaces or dashes
+	telemetryv1beta1.RedactionDetectorCreditCard: `\b(?:4\d{3}|5[1-5]\d{2}|2[2-7]\d{2}|3[47]\d{2}|6(?:011|5\d{2}))(?:[ -]?\d{4}){2}[ -]?\d{3,4}\b`,
+	telemetryv1beta1.RedactionDetectorEmail:      `[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`,
+	// The header and payload of a JWT are base64url-encoded JSON objects, which always start with eyJ
+	telemetryv1beta1.RedactionDetectorJWT:  `eyJ[a-zA-Z0-9_-]+\.eyJ[a-zA-Z0-9_-]+\.[a-zA-Z0-9_-]*`,
+	telemetryv1beta1.RedactionDetectorIBAN: `\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`,
+}
+
+// RedactionProcessor creates a redaction processor configuration, which masks the values matching the built-in detectors or the custom patterns in all attributes except the allowed keys.
+func RedactionProcessor(redaction *telemetryv1beta1.RedactionSpec) *RedactionProcessorConfig {
+	var blockedValues []string
+
+	for _, detector := range redaction.Detectors {
+		blockedValues = append(blockedValues, RedactionDetectorPatterns[detector])
+	}
+
+	blockedValues = append(blockedValues, redaction.Patterns...)
+
+	return &RedactionProcessorConfig{
+		AllowAllKeys:  true,
+		IgnoredKeys:   redaction.AllowedKeys,
+		BlockedValues: blockedValues,
+		Summary:       "silent",
+	}
+}
+
 // =============================================================================
 // FILTER PROCESSOR BUILDERS
 // =============================================================================
 
+// PodSelectorFilterConditions returns the conditions for a filter processor that drops the logs of all pods not matching the pod selector.
+// It relies on the pod metadata extracted with ExtractPodSelectorMetadata.
+func PodSelectorFilterConditions(selector *telemetryv1beta1.LogPipelinePodSelector) []string {
+	var conditions []string
+
+	if selector.Labels != nil {
+		for _, key := range slices.Sorted(maps.Keys(selector.Labels.MatchLabels)) {
+			conditions = append(conditions, fmt.Sprintf("%s != %q", ResourceAttribute(kymaPodSelectorLabelPrefix+key), selector.Labels.MatchLabels[key]))
+		}
+
+		for _, expr := range selector.Labels.MatchExpressions {
+			attribute := ResourceAttribute(kymaPodSelectorLabelPrefix + expr.Key)
+
+			var valueMatches []string
+			for _, value := range expr.Values {
+				valueMatches = append(valueMatches, fmt.Sprintf("%s == %q", attribute, value))
+			}
+
+			switch expr.Operator {
+			case metav1.LabelSelectorOpIn:
+				conditions = append(conditions, fmt.Sprintf("not %s", JoinWithOr(valueMatches...)))
+			case metav1.LabelSelectorOpNotIn:
+				conditions = append(conditions, JoinWithOr(valueMatches...))
+			case metav1.LabelSelectorOpExists:
+				conditions = append(conditions, IsNil(attribute))
+			case metav1.LabelSelectorOpDoesNotExist:
+				conditions = append(conditions, IsNotNil(attribute))
+			}
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(selector.Annotations)) {
+		conditions = append(conditions, fmt.Sprintf("%s != %q", ResourceAttribute(kymaPodSelectorAnnotationPrefix+key), selector.Annotations[key]))
+	}
+
+	return conditions
+}
+
+// logSeverityNumbers maps the severity levels to the OTTL enums of their lowest severity numbers.
+var logSeverityNumbers = map[telemetryv1beta1.LogSeverity]string{
+	telemetryv1beta1.LogSeverityTrace: "SEVERITY_NUMBER_TRACE",
+	telemetryv1beta1.LogSeverityDebug: "SEVERITY_NUMBER_DEBUG",
+	telemetryv1beta1.LogSeverityInfo:  "SEVERITY_NUMBER_INFO",
+	telemetryv1beta1.LogSeverityWarn:  "SEVERITY_NUMBER_WARN",
+	telemetryv1beta1.LogSeverityError: "SEVERITY_NUMBER_ERROR",
+	telemetryv1beta1.LogSeverityFatal: "SEVERITY_NUMBER_FATAL",
+}
+
+// MinSeverityFilterProcessor creates a filter processor configuration that drops logs with a severity lower than the minimum severity.
+// Logs without a severity are kept. Namespace overrides are evaluated in order, and the first matching one applies.
+// Returns nil if all logs are kept.
+func MinSeverityFilterProcessor(minSeverity *telemetryv1beta1.LogPipelineMinSeverity) *FilterProcessorConfig {
+	var (
+		dropConditions []string
+		// conditions that are true if none of the previous overrides matches
+		notMatchedByPrevious []string
+	)
+
+	for _, override := range minSeverity.Overrides {
+		matches, notMatches := NamespaceSelectorConditions(&override.Namespaces)
+
+		if override.Level != telemetryv1beta1.LogSeverityTrace {
+			dropConditions = append(dropConditions, JoinWithAnd(
+				slices.Concat(notMatchedByPrevious, []string{matches, severityBelow(override.Level)})...,
+			))
+		}
+
+		notMatchedByPrevious = append(notMatchedByPrevious, notMatches)
+	}
+
+	if minSeverity.Level != telemetryv1beta1.LogSeverityTrace {
+		dropConditions = append(dropConditions, JoinWithAnd(
+			slices.Concat(notMatchedByPrevious, []string{severityBelow(minSeverity.Level)})...,
+		))
+	}
+
+	if len(dropConditions) == 0 {
+		return nil
+	}
+
+	return LogFilterProcessor([]telemetryv1beta1.FilterSpec{{Conditions: dropConditions}})
+}
+
+// severityBelow returns an OTTL condition that matches logs with a known severity lower than the given level.
+func severityBelow(level telemetryv1beta1.LogSeverity) string {
+	return JoinWithAnd(
+		"log.severity_number > SEVERITY_NUMBER_UNSPECIFIED",
+		fmt.Sprintf("log.severity_number < %s", logSeverityNumbers[level]),
+	)
+}
+
+// NamespaceSelectorConditions returns OTTL conditions that match telemetry data from namespaces selected and not selected by the given selector.
+func NamespaceSelectorConditions(namespaceSelector *telemetryv1beta1.NamespaceSelector) (matches string, notMatches string) {
+	if len(namespaceSelector.Include) > 0 {
+		included := JoinWithOr(namespacesEqual(namespaceSelector.Include)...)
+		return included, Not(included)
+	}
+
+	excluded := JoinWithOr(namespacesEqual(namespaceSelector.Exclude)...)
+
+	return Not(excluded), excluded
+}
+
+func namespacesEqual(namespaces []string) []string {
+	conditions := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		conditions = append(conditions, NamespaceEquals(ns))
+	}
+
+	return conditions
+}
+
 // LogFilterProcessor creates a FilterProcessorConfig for logs with error_mode set to "ignore"
 func LogFilterProcessor(filters []telemetryv1beta1.FilterSpec) *FilterProcessorConfig {
 	return &FilterProcessorConfig{
@@ -234,6 +413,145 @@ func TransformSpecsToProcessorStatements(specs []telemetryv1beta1.TransformSpec)
 	return result
 }
 
+var metricAggregationFunctions = map[telemetryv1beta1.MetricAggregationType]string{
+	telemetryv1beta1.MetricAggregationTypeSum:    "sum",
+	telemetryv1beta1.MetricAggregationTypeAvg:    "avg",
+	telemetryv1beta1.MetricAggregationTypeMin:    "min",
+	telemetryv1beta1.MetricAggregationTypeMax:    "max",
+	telemetryv1beta1.MetricAggregationTypeMedian: "median",
+	telemetryv1beta1.MetricAggregationTypeCount:  "count",
+}
+
+// metricAggregationResourceAttributes lists the resource attributes that are set by the enrichment.
+// Unless they are aggregated away, they are moved back from the data points to the resources of the aggregated metrics.
+var metricAggregationResourceAttributes = []string{
+	"k8s.cluster.name",
+	"k8s.cluster.uid",
+	"cloud.provider",
+	"cloud.region",
+	"cloud.availability_zone",
+	"host.type",
+	"k8s.node.name",
+	"k8s.namespace.name",
+	"k8s.pod.name",
+	"k8s.deployment.name",
+	"k8s.statefulset.name",
+	"k8s.daemonset.name",
+	"k8s.cronjob.name",
+	"k8s.job.name",
+	"service.namespace",
+	"service.name",
+	"service.version",
+	"service.instance.id",
+}
+
+// MetricAggregationRoutingConnector creates a routing connector that sends the metrics matching any of the aggregations to the aggregation service pipeline,
+// and all other metrics directly to the export service pipeline.
+func MetricAggregationRoutingConnector(aggregations []telemetryv1beta1.MetricAggregation, aggregationPipelineID, exportPipelineID string) *RoutingConnectorConfig {
+	var conditions []string
+	for _, aggregation := range aggregations {
+		conditions = append(conditions, metricAggregationCondition(aggregation))
+	}
+
+	return &RoutingConnectorConfig{
+		DefaultPipelines: []string{exportPipelineID},
+		ErrorMode:        "ignore",
+		Table: []RoutingConnectorTableEntry{
+			{
+				Context:   "metric",
+				Statement: JoinWithWhere("route()", JoinWithOr(conditions...)),
+				Pipelines: []string{aggregationPipelineID},
+			},
+		},
+	}
+}
+
+// MetricAggregationFlattenProcessorStatements creates processor statements for the transform processor that moves all resource attributes onto the data points.
+// Afterward, the resources of all metrics are empty and can be merged, so that the data points of different resources, like different Pods, are aggregated together.
+func MetricAggregationFlattenProcessorStatements() []TransformProcessorStatements {
+	return []TransformProcessorStatements{
+		{
+			Statements: []string{"merge_maps(datapoint.attributes, resource.attributes, \"insert\")"},
+		},
+		{
+			Statements: []string{"delete_matching_keys(resource.attributes, \".*\")"},
+		},
+	}
+}
+
+// MetricAggregationMergeProcessor creates a processor that merges resources with equal attributes, and the metrics with the same name within them.
+func MetricAggregationMergeProcessor() *GroupByAttrsProcessorConfig {
+	return &GroupByAttrsProcessorConfig{}
+}
+
+// MetricAggregationRestoreProcessor creates a processor that moves the remaining resource attributes set by the enrichment from the data points back to the resources.
+func MetricAggregationRestoreProcessor() *GroupByAttrsProcessorConfig {
+	return &GroupByAttrsProcessorConfig{
+		Keys: metricAggregationResourceAttributes,
+	}
+}
+
+// MetricAggregationProcessorStatements creates processor statements for the transform processor that aggregates the data points of the matching metrics.
+// Dropped labels are deleted from the data points first, so that the data points with the same remaining attributes are aggregated afterwards.
+// The resource attributes must have been moved onto the data points before (see MetricAggregationFlattenProcessorStatements), so that they can be kept or dropped like labels.
+func MetricAggregationProcessorStatements(aggregations []telemetryv1beta1.MetricAggregation) []TransformProcessorStatements {
+	var result []TransformProcessorStatements
+
+	for _, aggregation := range aggregations {
+		condition := metricAggregationCondition(aggregation)
+
+		function, ok := metricAggregationFunctions[aggregation.Type]
+		if !ok {
+			function = metricAggregationFunctions[telemetryv1beta1.MetricAggregationTypeSum]
+		}
+
+		if len(aggregation.DropLabels) > 0 {
+			var deleteStatements []string
+			for _, label := range aggregation.DropLabels {
+				deleteStatements = append(deleteStatements, fmt.Sprintf("delete_key(datapoint.attributes, %q)", label))
+			}
+
+			result = append(result,
+				TransformProcessorStatements{
+					Statements: deleteStatements,
+					Conditions: []string{condition},
+				},
+				TransformProcessorStatements{
+					Statements: []string{fmt.Sprintf("aggregate_on_attributes(%q)", function)},
+					Conditions: []string{condition},
+				},
+			)
+
+			continue
+		}
+
+		keepLabels := make([]string, 0, len(aggregation.KeepLabels))
+		for _, label := range aggregation.KeepLabels {
+			keepLabels = append(keepLabels, fmt.Sprintf("%q", label))
+		}
+
+		result = append(result, TransformProcessorStatements{
+			Statements: []string{fmt.Sprintf("aggregate_on_attributes(%q, [%s])", function, strings.Join(keepLabels, ", "))},
+			Conditions: []string{condition},
+		})
+	}
+
+	return result
+}
+
+func metricAggregationCondition(aggregation telemetryv1beta1.MetricAggregation) string {
+	if aggregation.MetricNamePattern != "" {
+		return fmt.Sprintf("IsMatch(metric.name, %q)", aggregation.MetricNamePattern)
+	}
+
+	var nameConditions []string
+	for _, name := range aggregation.MetricNames {
+		nameConditions = append(nameConditions, fmt.Sprintf("metric.name == %q", name))
+	}
+
+	return JoinWithOr(nameConditions...)
+}
+
 type ClusterOptions struct {
 	ClusterName   string
 	ClusterUID    string
@@ -302,6 +620,28 @@ func RestoreOtelServiceAnnotationsProcessorStatements() []TransformProcessorStat
 	return []TransformProcessorStatements{{Statements: stmts}}
 }
 
+// ElasticsearchIndexProcessorStatements creates processor statements for the transform processor that selects the index of a log
+// from the resource attribute configured in the Elasticsearch output. Returns nil if the output doesn't select the index from a resource attribute.
+func ElasticsearchIndexProcessorStatements(esOutput *telemetryv1beta1.ElasticsearchOutput) []TransformProcessorStatements {
+	if esOutput == nil || esOutput.IndexFromResourceAttribute == "" {
+		return nil
+	}
+
+	index := ResourceAttribute(esOutput.IndexFromResourceAttribute)
+	if esOutput.Index != "" {
+		index = fmt.Sprintf("Concat([\"%s\", %s], \"-\")", esOutput.Index, index)
+	}
+
+	return []TransformProcessorStatements{{
+		Statements: []string{
+			JoinWithWhere(
+				fmt.Sprintf("set(%s, %s)", ResourceAttribute(elasticsearchIndexAttribute), index),
+				ResourceAttributeIsNotNil(esOutput.IndexFromResourceAttribute),
+			),
+		},
+	}}
+}
+
 // InstrumentationScopeProcessor creates a transform processor for instrumentation scope
 func InstrumentationScopeProcessor(instrumentationScopeVersion string, inputSource ...InputSourceType) *TransformProcessorConfig {
 	statements := []string{}
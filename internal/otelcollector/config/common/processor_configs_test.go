@@ -0,0 +1,178 @@
+package common
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"gopkg.in/yaml.v3"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+// processorFactories holds the factories of the processors whose configuration can be validated in this module.
+var processorFactories = map[string]processor.Factory{
+	"filter":    filterprocessor.NewFactory(),
+	"transform": transformprocessor.NewFactory(),
+}
+
+// TestGoldenFilesHaveValidProcessorConfigs verifies that the configurations of the generated processors with an available factory are accepted by the factory.
+// User-defined processors are skipped, because their statements are test fixtures that are validated by the webhooks.
+func TestGoldenFilesHaveValidProcessorConfigs(t *testing.T) {
+	goldenFiles, err := filepath.Glob("../*/testdata/*.yaml")
+	require.NoError(t, err)
+	require.NotEmpty(t, goldenFiles)
+
+	for _, goldenFile := range goldenFiles {
+		t.Run(goldenFile, func(t *testing.T) {
+			data, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+
+			var config map[string]any
+			require.NoError(t, yaml.Unmarshal(data, &config))
+
+			processors, _ := config["processors"].(map[string]any)
+			for id, processorConfig := range processors {
+				processorType, _, _ := strings.Cut(id, "/")
+
+				if factory, ok := processorFactories[processorType]; ok && !strings.Contains(id, "user-defined") {
+					requireValidProcessorConfig(t, factory, id, processorConfig)
+				}
+			}
+		})
+	}
+}
+
+func requireValidProcessorConfig(t *testing.T, factory processor.Factory, id string, processorConfig any) {
+	t.Helper()
+
+	rawConfig, _ := processorConfig.(map[string]any)
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, confmap.NewFromStringMap(rawConfig).Unmarshal(cfg), "processor %s has an invalid configuration", id)
+
+	if validator, ok := cfg.(interface{ Validate() error }); ok {
+		require.NoError(t, validator.Validate(), "processor %s has an invalid configuration", id)
+	}
+}
+
+// TestMetricAggregationMergesResources runs the processors of the aggregation service pipeline on the data points of two Pods,
+// and verifies that the data points are aggregated across the resources of the Pods.
+func TestMetricAggregationMergesResources(t *testing.T) {
+	timestamp := pcommon.NewTimestampFromTime(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+
+	input := pmetric.NewMetrics()
+	for pod, value := range map[string]float64{"backend-1": 1, "backend-2": 2} {
+		resourceMetrics := input.ResourceMetrics().AppendEmpty()
+		resourceMetrics.Resource().Attributes().PutStr("k8s.namespace.name", "shop")
+		resourceMetrics.Resource().Attributes().PutStr("k8s.pod.name", pod)
+		resourceMetrics.Resource().Attributes().PutStr("service.name", "backend")
+
+		sum := resourceMetrics.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		sum.SetName("http_server_requests_total")
+		sum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		dataPoint := sum.Sum().DataPoints().AppendEmpty()
+		dataPoint.SetTimestamp(timestamp)
+		dataPoint.SetDoubleValue(value)
+		dataPoint.Attributes().PutStr("http.method", "GET")
+	}
+
+	aggregations := []telemetryv1beta1.MetricAggregation{{
+		MetricNames: []string{"http_server_requests_total"},
+		DropLabels:  []string{"k8s.pod.name"},
+		Type:        telemetryv1beta1.MetricAggregationTypeSum,
+	}}
+
+	flattened := runTransformProcessor(t, MetricTransformProcessor(MetricAggregationFlattenProcessorStatements()), input)
+	aggregated := runTransformProcessor(t, MetricTransformProcessor(MetricAggregationProcessorStatements(aggregations)), mergeResources(flattened))
+
+	require.Equal(t, 1, aggregated.ResourceMetrics().Len(), "resources of the Pods are not merged")
+
+	dataPoints := aggregated.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	require.Equal(t, 1, dataPoints.Len(), "data points of the Pods are not aggregated")
+	require.Equal(t, 3.0, dataPoints.At(0).DoubleValue())
+	require.Equal(t, map[string]any{
+		"http.method":        "GET",
+		"k8s.namespace.name": "shop",
+		"service.name":       "backend",
+	}, dataPoints.At(0).Attributes().AsRaw())
+}
+
+func runTransformProcessor(t *testing.T, config *TransformProcessorConfig, metrics pmetric.Metrics) pmetric.Metrics {
+	t.Helper()
+
+	data, err := yaml.Marshal(config)
+	require.NoError(t, err)
+
+	var rawConfig map[string]any
+	require.NoError(t, yaml.Unmarshal(data, &rawConfig))
+
+	factory := processorFactories["transform"]
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, confmap.NewFromStringMap(rawConfig).Unmarshal(cfg))
+
+	sink := new(consumertest.MetricsSink)
+	transform, err := factory.CreateMetrics(context.Background(), processortest.NewNopSettings(factory.Type()), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, transform.ConsumeMetrics(context.Background(), metrics))
+
+	return sink.AllMetrics()[0]
+}
+
+// mergeResources merges the resources with equal attributes, and the metrics with the same name within them,
+// like the groupbyattrs processor without keys does. The processor itself is not a dependency of this module.
+func mergeResources(metrics pmetric.Metrics) pmetric.Metrics {
+	merged := pmetric.NewMetrics()
+
+	for _, resourceMetrics := range metrics.ResourceMetrics().All() {
+		target := findOrAppendResource(merged, resourceMetrics.Resource())
+
+		for _, scopeMetrics := range resourceMetrics.ScopeMetrics().All() {
+			for _, metric := range scopeMetrics.Metrics().All() {
+				targetMetric := findOrAppendMetric(target, metric)
+				metric.Sum().DataPoints().MoveAndAppendTo(targetMetric.Sum().DataPoints())
+			}
+		}
+	}
+
+	return merged
+}
+
+func findOrAppendResource(metrics pmetric.Metrics, resource pcommon.Resource) pmetric.ScopeMetrics {
+	for _, resourceMetrics := range metrics.ResourceMetrics().All() {
+		if reflect.DeepEqual(resourceMetrics.Resource().Attributes().AsRaw(), resource.Attributes().AsRaw()) {
+			return resourceMetrics.ScopeMetrics().At(0)
+		}
+	}
+
+	resourceMetrics := metrics.ResourceMetrics().AppendEmpty()
+	resource.CopyTo(resourceMetrics.Resource())
+
+	return resourceMetrics.ScopeMetrics().AppendEmpty()
+}
+
+func findOrAppendMetric(scopeMetrics pmetric.ScopeMetrics, metric pmetric.Metric) pmetric.Metric {
+	for _, existing := range scopeMetrics.Metrics().All() {
+		if existing.Name() == metric.Name() {
+			return existing
+		}
+	}
+
+	target := scopeMetrics.Metrics().AppendEmpty()
+	target.SetName(metric.Name())
+	target.SetEmptySum().SetAggregationTemporality(metric.Sum().AggregationTemporality())
+
+	return target
+}
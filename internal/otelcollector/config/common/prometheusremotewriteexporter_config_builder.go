@@ -0,0 +1,82 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+// =============================================================================
+// PROMETHEUS REMOTE-WRITE EXPORTER CONFIG BUILDER
+// =============================================================================
+
+// remoteWriteNumConsumers is the number of concurrent remote-write requests per exporter (the default of the exporter)
+const remoteWriteNumConsumers = 5
+
+type PrometheusRemoteWriteExporterConfigBuilder struct {
+	reader       client.Reader
+	prwOutput    *telemetryv1beta1.PrometheusRemoteWriteOutput
+	pipelineRef  pipelines.PipelineRef
+	sendingQueue SendingQueue
+}
+
+func NewPrometheusRemoteWriteExporterConfigBuilder(reader client.Reader, prwOutput *telemetryv1beta1.PrometheusRemoteWriteOutput, pipelineRef pipelines.PipelineRef, sendingQueue SendingQueue) *PrometheusRemoteWriteExporterConfigBuilder {
+	return &PrometheusRemoteWriteExporterConfigBuilder{
+		reader:       reader,
+		prwOutput:    prwOutput,
+		pipelineRef:  pipelineRef,
+		sendingQueue: sendingQueue,
+	}
+}
+
+func (cb *PrometheusRemoteWriteExporterConfigBuilder) PrometheusRemoteWriteExporter(ctx context.Context) (*PrometheusRemoteWriteExporterConfig, EnvVars, error) {
+	// The connection settings are shared with OTLP/HTTP outputs, so the same environment variables are used to resolve them
+	httpOutput := pipelines.PrometheusRemoteWriteOutput{Ref: cb.pipelineRef, PrometheusRemoteWrite: cb.prwOutput}.OTLPOutput().OTLP
+
+	envVars, err := makeOTLPExporterEnvVars(ctx, cb.reader, httpOutput, cb.pipelineRef)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to make env vars: %w", err)
+	}
+
+	exporter := prometheusRemoteWriteExporter(cb.prwOutput, httpOutput, cb.pipelineRef, envVars, cb.sendingQueue)
+
+	return exporter, envVars, nil
+}
+
+func prometheusRemoteWriteExporter(prwOutput *telemetryv1beta1.PrometheusRemoteWriteOutput, httpOutput *telemetryv1beta1.OTLPOutput, pipelineRef pipelines.PipelineRef, envVars map[string][]byte, sendingQueue SendingQueue) *PrometheusRemoteWriteExporterConfig {
+	endpointVariable := formatEnvVarKey(otlpEndpointVariablePrefix, pipelineRef)
+	endpointValue := string(envVars[endpointVariable])
+
+	exporter := PrometheusRemoteWriteExporterConfig{
+		Endpoint: fmt.Sprintf("${%s}", endpointVariable),
+		Headers:  headers(httpOutput, pipelineRef),
+		TLS:      tls(httpOutput, endpointValue, pipelineRef),
+		ResourceToTelemetryConversion: ResourceToTelemetryConversion{
+			Enabled: prwOutput.ResourceAttributesToLabels != nil && *prwOutput.ResourceAttributesToLabels,
+		},
+		// The remote-write exporter does not support the generic sending queue but has its own queue
+		RemoteWriteQueue: RemoteWriteQueue{
+			Enabled:      sendingQueue.Enabled,
+			QueueSize:    sendingQueue.QueueSize,
+			NumConsumers: remoteWriteNumConsumers,
+		},
+		RetryOnFailure: RetryOnFailure{
+			Enabled:         true,
+			InitialInterval: "5s",
+			MaxInterval:     "30s",
+			MaxElapsedTime:  "300s",
+		},
+	}
+
+	if prwOutput.Authentication != nil && prwOutput.Authentication.OAuth2 != nil {
+		exporter.Auth = Auth{
+			Authenticator: ComponentIDOAuth2Extension(pipelineRef),
+		}
+	}
+
+	return &exporter
+}
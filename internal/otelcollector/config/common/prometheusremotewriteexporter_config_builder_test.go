@@ -0,0 +1,78 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+func TestPrometheusRemoteWriteExporterID(t *testing.T) {
+	require.Equal(t, "prometheusremotewrite/metricpipeline-test", ComponentIDPrometheusRemoteWriteExporter(metricRefTest()))
+}
+
+func TestMakePrometheusRemoteWriteExporterConfig(t *testing.T) {
+	output := &telemetryv1beta1.PrometheusRemoteWriteOutput{
+		Endpoint: telemetryv1beta1.ValueType{Value: "https://prometheus:9090/api/v1/write"},
+	}
+
+	cb := NewPrometheusRemoteWriteExporterConfigBuilder(fake.NewClientBuilder().Build(), output, metricRefTest(), NewSendingQueue(512))
+	exporterConfig, envVars, err := cb.PrometheusRemoteWriteExporter(t.Context())
+	require.NoError(t, err)
+	require.NotNil(t, envVars)
+
+	require.Equal(t, "${OTLP_ENDPOINT_METRICPIPELINE_TEST}", exporterConfig.Endpoint)
+	require.Equal(t, []byte("https://prometheus:9090/api/v1/write"), envVars["OTLP_ENDPOINT_METRICPIPELINE_TEST"])
+	require.False(t, exporterConfig.TLS.Insecure)
+	require.False(t, exporterConfig.ResourceToTelemetryConversion.Enabled)
+	require.Empty(t, exporterConfig.Auth.Authenticator)
+
+	require.True(t, exporterConfig.RemoteWriteQueue.Enabled)
+	require.Equal(t, 512, exporterConfig.RemoteWriteQueue.QueueSize)
+	require.Equal(t, 5, exporterConfig.RemoteWriteQueue.NumConsumers)
+
+	require.True(t, exporterConfig.RetryOnFailure.Enabled)
+	require.Equal(t, "5s", exporterConfig.RetryOnFailure.InitialInterval)
+	require.Equal(t, "30s", exporterConfig.RetryOnFailure.MaxInterval)
+	require.Equal(t, "300s", exporterConfig.RetryOnFailure.MaxElapsedTime)
+}
+
+func TestMakePrometheusRemoteWriteExporterConfigWithResourceAttributesToLabels(t *testing.T) {
+	output := &telemetryv1beta1.PrometheusRemoteWriteOutput{
+		Endpoint:                   telemetryv1beta1.ValueType{Value: "http://prometheus:9090/api/v1/write"},
+		ResourceAttributesToLabels: ptr.To(true),
+	}
+
+	cb := NewPrometheusRemoteWriteExporterConfigBuilder(fake.NewClientBuilder().Build(), output, metricRefTest(), NewSendingQueue(512))
+	exporterConfig, _, err := cb.PrometheusRemoteWriteExporter(t.Context())
+	require.NoError(t, err)
+	require.True(t, exporterConfig.ResourceToTelemetryConversion.Enabled)
+	require.True(t, exporterConfig.TLS.Insecure)
+}
+
+func TestMakePrometheusRemoteWriteExporterConfigWithAuthentication(t *testing.T) {
+	output := &telemetryv1beta1.PrometheusRemoteWriteOutput{
+		Endpoint: telemetryv1beta1.ValueType{Value: "https://prometheus:9090/api/v1/write"},
+		Authentication: &telemetryv1beta1.AuthenticationOptions{
+			Basic: &telemetryv1beta1.BasicAuthOptions{
+				User:     telemetryv1beta1.ValueType{Value: "user"},
+				Password: telemetryv1beta1.ValueType{Value: "password"},
+			},
+		},
+		Headers: []telemetryv1beta1.Header{
+			{Name: "X-Scope-OrgID", ValueType: telemetryv1beta1.ValueType{Value: "tenant"}},
+		},
+	}
+
+	cb := NewPrometheusRemoteWriteExporterConfigBuilder(fake.NewClientBuilder().Build(), output, metricRefTest(), NewSendingQueue(512))
+	exporterConfig, envVars, err := cb.PrometheusRemoteWriteExporter(t.Context())
+	require.NoError(t, err)
+
+	require.Equal(t, "${BASIC_AUTH_HEADER_METRICPIPELINE_TEST}", exporterConfig.Headers["Authorization"])
+	require.Equal(t, "${HEADER_METRICPIPELINE_TEST_X_SCOPE_ORGID}", exporterConfig.Headers["X-Scope-OrgID"])
+	require.Contains(t, envVars, "BASIC_AUTH_HEADER_METRICPIPELINE_TEST")
+	require.Equal(t, []byte("tenant"), envVars["HEADER_METRICPIPELINE_TEST_X_SCOPE_ORGID"])
+}
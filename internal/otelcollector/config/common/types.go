@@ -132,7 +132,8 @@ type ReceiverProtocols struct {
 }
 
 type Endpoint struct {
-	Endpoint string `yaml:"endpoint,omitempty"`
+	Endpoint        string `yaml:"endpoint,omitempty"`
+	IncludeMetadata bool   `yaml:"include_metadata,omitempty"`
 }
 
 // =============================================================================
@@ -147,7 +148,7 @@ type OTLPExporterConfig struct {
 	Headers         map[string]string `yaml:"headers,omitempty"`
 	TLS             TLS               `yaml:"tls,omitempty"`
 	Compression     string            `yaml:"compression,omitempty"`
-	SendingQueue    SendingQueue      `yaml:"sending_queue,omitempty"`
+	SendingQueue    *SendingQueue     `yaml:"sending_queue,omitempty"`
 	RetryOnFailure  RetryOnFailure    `yaml:"retry_on_failure,omitempty"`
 	Auth            Auth              `yaml:"auth,omitempty"`
 }
@@ -160,11 +161,87 @@ type TLS struct {
 	CAPem              string `yaml:"ca_pem,omitempty"`
 }
 
+type KafkaExporterConfig struct {
+	Brokers                              []string           `yaml:"brokers"`
+	Traces                               *KafkaSignalConfig `yaml:"traces,omitempty"`
+	Metrics                              *KafkaSignalConfig `yaml:"metrics,omitempty"`
+	Logs                                 *KafkaSignalConfig `yaml:"logs,omitempty"`
+	PartitionTracesByID                  bool               `yaml:"partition_traces_by_id,omitempty"`
+	PartitionMetricsByResourceAttributes bool               `yaml:"partition_metrics_by_resource_attributes,omitempty"`
+	PartitionLogsByResourceAttributes    bool               `yaml:"partition_logs_by_resource_attributes,omitempty"`
+	PartitionLogsByTraceID               bool               `yaml:"partition_logs_by_trace_id,omitempty"`
+	Auth                                 *KafkaAuth         `yaml:"auth,omitempty"`
+	TLS                                  *TLS               `yaml:"tls,omitempty"`
+	SendingQueue                         *SendingQueue      `yaml:"sending_queue,omitempty"`
+	RetryOnFailure                       RetryOnFailure     `yaml:"retry_on_failure,omitempty"`
+}
+
+type KafkaSignalConfig struct {
+	Topic    string `yaml:"topic"`
+	Encoding string `yaml:"encoding"`
+}
+
+type KafkaAuth struct {
+	SASL *KafkaSASL `yaml:"sasl,omitempty"`
+}
+
+type KafkaSASL struct {
+	Username  string `yaml:"username"`
+	Password  string `yaml:"password"`
+	Mechanism string `yaml:"mechanism"`
+}
+
+type PrometheusRemoteWriteExporterConfig struct {
+	Endpoint                      string                        `yaml:"endpoint"`
+	Headers                       map[string]string             `yaml:"headers,omitempty"`
+	TLS                           TLS                           `yaml:"tls,omitempty"`
+	Auth                          Auth                          `yaml:"auth,omitempty"`
+	ResourceToTelemetryConversion ResourceToTelemetryConversion `yaml:"resource_to_telemetry_conversion,omitempty"`
+	RemoteWriteQueue              RemoteWriteQueue              `yaml:"remote_write_queue"`
+	RetryOnFailure                RetryOnFailure                `yaml:"retry_on_failure,omitempty"`
+}
+
+type ElasticsearchExporterConfig struct {
+	Endpoint     string             `yaml:"endpoint"`
+	LogsIndex    string             `yaml:"logs_index,omitempty"`
+	Headers      map[string]string  `yaml:"headers,omitempty"`
+	TLS          TLS                `yaml:"tls,omitempty"`
+	Auth         Auth               `yaml:"auth,omitempty"`
+	SendingQueue *SendingQueue      `yaml:"sending_queue,omitempty"`
+	Retry        ElasticsearchRetry `yaml:"retry"`
+}
+
+type ElasticsearchRetry struct {
+	Enabled         bool   `yaml:"enabled"`
+	InitialInterval string `yaml:"initial_interval,omitempty"`
+	MaxInterval     string `yaml:"max_interval,omitempty"`
+	MaxRetries      int    `yaml:"max_retries,omitempty"`
+}
+
+type PrometheusExporterConfig struct {
+	Endpoint         string `yaml:"endpoint"`
+	MetricExpiration string `yaml:"metric_expiration,omitempty"`
+}
+
+type ResourceToTelemetryConversion struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+type RemoteWriteQueue struct {
+	Enabled      bool `yaml:"enabled"`
+	QueueSize    int  `yaml:"queue_size,omitempty"`
+	NumConsumers int  `yaml:"num_consumers,omitempty"`
+}
+
 type SendingQueue struct {
-	Enabled   bool  `yaml:"enabled"`
-	QueueSize int   `yaml:"queue_size"`
-	Sizer     Sizer `yaml:"sizer,omitempty"`
-	Batch     Batch `yaml:"batch,omitempty"`
+	Enabled   bool   `yaml:"enabled"`
+	QueueSize int    `yaml:"queue_size"`
+	Sizer     Sizer  `yaml:"sizer,omitempty"`
+	Batch     Batch  `yaml:"batch,omitempty"`
+	StorageID string `yaml:"storage,omitempty"`
+
+	// storageDirectory is the directory of the file storage extension used by persistent sending queues
+	storageDirectory string
 }
 
 type Batch struct {
@@ -213,6 +290,10 @@ type K8sAttributesFilterConfig struct {
 	NodeFromEnvVar string `yaml:"node_from_env_var"`
 }
 
+type DeltaToCumulativeProcessorConfig struct {
+	MaxStale string `yaml:"max_stale,omitempty"`
+}
+
 type CumulativeToDeltaProcessorConfig struct {
 	MaxStaleness time.Duration `yaml:"max_staleness,omitempty"`
 	InitialValue string        `yaml:"initial_value"`
@@ -253,6 +334,10 @@ type TransformProcessorStatements struct {
 	Conditions []string `yaml:"conditions,omitempty"`
 }
 
+type GroupByAttrsProcessorConfig struct {
+	Keys []string `yaml:"keys,omitempty"`
+}
+
 type ServiceEnrichmentProcessorConfig struct {
 	ResourceAttributes []string `yaml:"resource_attributes"`
 }
@@ -260,6 +345,13 @@ type ServiceEnrichmentProcessorConfig struct {
 type IstioNoiseFilterProcessorConfig struct {
 }
 
+type RedactionProcessorConfig struct {
+	AllowAllKeys  bool     `yaml:"allow_all_keys"`
+	IgnoredKeys   []string `yaml:"ignored_keys,omitempty"`
+	BlockedValues []string `yaml:"blocked_values"`
+	Summary       string   `yaml:"summary"`
+}
+
 type FilterProcessorConfig struct {
 	ErrorMode string                        `yaml:"error_mode"`
 	Metrics   []telemetryv1beta1.FilterSpec `yaml:"metric_conditions,omitempty"`
@@ -285,3 +377,20 @@ type RoutingConnectorTableEntry struct {
 
 type ForwardConnectorConfig struct {
 }
+
+type FailoverConnectorConfig struct {
+	PriorityLevels [][]string `yaml:"priority_levels"`
+	RetryInterval  string     `yaml:"retry_interval"`
+}
+
+type SpanMetricsConnectorConfig struct {
+	Dimensions []SpanMetricsDimension `yaml:"dimensions,omitempty"`
+}
+
+type SpanMetricsDimension struct {
+	Name string `yaml:"name"`
+}
+
+type ServiceGraphConnectorConfig struct {
+	Dimensions []string `yaml:"dimensions,omitempty"`
+}
@@ -15,11 +15,20 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
 )
 
 const checkpointVolumePathSubdir = "telemetry-log-agent/file-log-receiver"
 
+const (
+	defaultDeduplicateInterval   = "10s"
+	deduplicateLogCountAttribute = "log_count"
+)
+
+// sendingQueueStorageDirectory is the directory in which the persistent sending queues of the exporters are stored
+var sendingQueueStorageDirectory = filepath.Join(otelcollector.SendingQueueVolumePath, names.LogAgent)
+
 // Exporter sending queue configuration constants, see
 // https://github.com/kyma-project/telemetry-manager/blob/main/docs/contributor/pocs/consistent-batching-across-components/02-log-agent-batching.md.
 const (
@@ -68,13 +77,18 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.LogPip
 	}, nil)
 	b.EnvVars = make(common.EnvVars)
 
+	podSelectors := make([]*telemetryv1beta1.LogPipelinePodSelector, 0, len(pipelines))
+	for i := range pipelines {
+		if pipelines[i].Spec.Input.Runtime != nil {
+			podSelectors = append(podSelectors, pipelines[i].Spec.Input.Runtime.Pods)
+		}
+	}
+
 	for _, pipeline := range pipelines {
 		pipelineID := formatLogServicePipelineID(&pipeline)
 
-		if shouldEnableOAuth2(&pipeline) {
-			if err := b.addOAuth2Extension(ctx, &pipeline); err != nil {
-				return nil, nil, err
-			}
+		if err := b.addOAuth2Extensions(ctx, &pipeline); err != nil {
+			return nil, nil, err
 		}
 
 		if err := b.AddServicePipeline(ctx, &pipeline, pipelineID,
@@ -82,16 +96,23 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.LogPip
 			b.addMemoryLimiterProcessor(),
 			b.addSetInstrumentationScopeToRuntimeProcessor(opts),
 			b.addDropUnknownServiceNameProcessor(opts),
-			b.addK8sAttributesProcessor(opts),
+			b.addK8sAttributesProcessor(opts, podSelectors),
+			b.addPodSelectorFilterProcessor(),
+			b.addMinSeverityFilterProcessor(),
 			b.addRestoreOtelServiceAttrsProcessor(opts),
 			b.addInsertClusterAttributesProcessor(opts),
 			b.addServiceEnrichmentProcessor(opts),
+			b.addDeduplicateProcessor(),
 			// Kyma attributes are dropped before user-defined transform and filter processors
 			// to prevent user access to internal attributes.
 			b.addDropKymaAttributesProcessor(),
+			b.addRedactionProcessor(),
 			b.addUserDefinedTransformProcessor(),
 			b.addUserDefinedFilterProcessor(),
-			b.addOTLPExporter(),
+			b.addElasticsearchIndexProcessor(),
+			b.addOTLPExporters(),
+			b.addKafkaExporters(),
+			b.addElasticsearchExporters(),
 		); err != nil {
 			return nil, nil, fmt.Errorf("failed to add service pipeline: %w", err)
 		}
@@ -150,12 +171,54 @@ func (b *Builder) addDropUnknownServiceNameProcessor(opts BuildOptions) buildCom
 	)
 }
 
-func (b *Builder) addK8sAttributesProcessor(opts BuildOptions) buildComponentFunc {
+// addK8sAttributesProcessor adds the k8sattributes processor, which is shared by all pipelines.
+// Therefore, it extracts the pod metadata referenced by the pod selectors of all pipelines.
+func (b *Builder) addK8sAttributesProcessor(opts BuildOptions, podSelectors []*telemetryv1beta1.LogPipelinePodSelector) buildComponentFunc {
 	return b.AddProcessor(
 		b.StaticComponentID(common.ComponentIDK8sAttributesProcessor),
 		func(lp *telemetryv1beta1.LogPipeline) any {
 			useOTelServiceEnrichment := opts.ServiceEnrichment == commonresources.AnnotationValueTelemetryServiceEnrichmentOtel
-			return common.K8sAttributesProcessor(opts.Enrichments, useOTelServiceEnrichment)
+			config := common.K8sAttributesProcessor(opts.Enrichments, useOTelServiceEnrichment)
+
+			podSelectorLabels, podSelectorAnnotations := common.ExtractPodSelectorMetadata(podSelectors)
+			config.Extract.Labels = append(config.Extract.Labels, podSelectorLabels...)
+			config.Extract.Annotations = append(config.Extract.Annotations, podSelectorAnnotations...)
+
+			return config
+		},
+	)
+}
+
+// addPodSelectorFilterProcessor drops the logs of all pods not matching the pod selector of the pipeline.
+func (b *Builder) addPodSelectorFilterProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatPodSelectorFilterProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			if lp.Spec.Input.Runtime == nil || lp.Spec.Input.Runtime.Pods == nil {
+				return nil
+			}
+
+			return common.LogFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{Conditions: common.PodSelectorFilterConditions(lp.Spec.Input.Runtime.Pods)},
+			})
+		},
+	)
+}
+
+// addMinSeverityFilterProcessor drops the logs with a severity lower than the minimum severity of the runtime input.
+func (b *Builder) addMinSeverityFilterProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatMinSeverityFilterProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			if lp.Spec.Input.Runtime == nil || lp.Spec.Input.Runtime.MinSeverity == nil {
+				return nil
+			}
+
+			if config := common.MinSeverityFilterProcessor(lp.Spec.Input.Runtime.MinSeverity); config != nil {
+				return config
+			}
+
+			return nil // All logs are kept, no processor needed
 		},
 	)
 }
@@ -196,6 +259,34 @@ func (b *Builder) addServiceEnrichmentProcessor(opts BuildOptions) buildComponen
 	)
 }
 
+func (b *Builder) addDeduplicateProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatDeduplicateProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			if lp.Spec.Deduplicate == nil {
+				return nil // No deduplication, no processor needed
+			}
+
+			return deduplicateProcessorConfig(lp.Spec.Deduplicate)
+		},
+	)
+}
+
+// deduplicateProcessorConfig returns the config of the processor collapsing identical logs. Logs are identical if their body, severity, attributes, and resource attributes are equal.
+// The original log line is excluded from the comparison, because it may contain a timestamp.
+func deduplicateProcessorConfig(dedup *telemetryv1beta1.LogPipelineDeduplicate) *LogDedupProcessorConfig {
+	interval := defaultDeduplicateInterval
+	if dedup.Interval != nil {
+		interval = dedup.Interval.Duration.String()
+	}
+
+	return &LogDedupProcessorConfig{
+		Interval:          interval,
+		LogCountAttribute: deduplicateLogCountAttribute,
+		ExcludeFields:     []string{`attributes.log\.original`},
+	}
+}
+
 func (b *Builder) addDropKymaAttributesProcessor() buildComponentFunc {
 	return b.AddProcessor(
 		b.StaticComponentID(common.ComponentIDDropKymaAttributesProcessor),
@@ -206,6 +297,19 @@ func (b *Builder) addDropKymaAttributesProcessor() buildComponentFunc {
 	)
 }
 
+func (b *Builder) addRedactionProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatRedactionProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			if lp.Spec.Redaction == nil {
+				return nil // No redaction, no processor needed
+			}
+
+			return common.RedactionProcessor(lp.Spec.Redaction)
+		},
+	)
+}
+
 func (b *Builder) addUserDefinedTransformProcessor() buildComponentFunc {
 	return b.AddProcessor(
 		formatUserDefinedTransformProcessorID,
@@ -234,48 +338,62 @@ func (b *Builder) addUserDefinedFilterProcessor() buildComponentFunc {
 	)
 }
 
-func (b *Builder) addOTLPExporter() buildComponentFunc {
-	return b.AddExporter(
-		formatOTLPExporterID,
-		func(ctx context.Context, lp *telemetryv1beta1.LogPipeline) (any, common.EnvVars, error) {
-			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
-				b.Reader,
-				lp.Spec.Output.OTLP,
-				pipelines.LogPipelineRef(lp),
-				common.NewSendingQueue(exporterQueueSize,
-					common.WithSizer(common.SizerBytes),
-					common.WithBatch(common.Batch{
-						MinSize:      exporterBatchMinSize,
-						MaxSize:      exporterBatchMaxSize,
-						FlushTimeout: exporterBatchFlushTimeout,
-					}),
-				),
-			)
-
-			return otlpExporterBuilder.OTLPExporter(ctx)
+func (b *Builder) addElasticsearchIndexProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatElasticsearchIndexProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			statements := common.ElasticsearchIndexProcessorStatements(lp.Spec.Output.Elasticsearch)
+			if statements == nil {
+				return nil // Static or default index, no processor needed
+			}
+
+			return common.LogTransformProcessor(statements)
 		},
 	)
 }
 
-func (b *Builder) addOAuth2Extension(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error {
-	oauth2ExtensionID := common.ComponentIDOAuth2Extension(pipelines.LogPipelineRef(pipeline))
-
-	oauth2ExtensionConfig, oauth2ExtensionEnvVars, err := common.NewOAuth2ExtensionConfigBuilder(
-		b.Reader,
-		pipeline.Spec.Output.OTLP.Authentication.OAuth2,
-		pipelines.LogPipelineRef(pipeline),
-	).OAuth2Extension(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to build OAuth2 extension for pipeline %s: %w", pipeline.Name, err)
-	}
+func (b *Builder) addOTLPExporters() buildComponentFunc {
+	return b.AddOTLPExporters(b.Reader, pipelines.LogPipelineOutputs,
+		common.NewSendingQueue(exporterQueueSize,
+			common.WithSizer(common.SizerBytes),
+			common.WithBatch(common.Batch{
+				MinSize:      exporterBatchMinSize,
+				MaxSize:      exporterBatchMaxSize,
+				FlushTimeout: exporterBatchFlushTimeout,
+			}),
+			common.WithPersistentStorage(sendingQueueStorageDirectory),
+		),
+	)
+}
 
-	b.AddExtension(oauth2ExtensionID, oauth2ExtensionConfig, oauth2ExtensionEnvVars)
+func (b *Builder) addKafkaExporters() buildComponentFunc {
+	return b.AddKafkaExporters(b.Reader, pipelines.LogPipelineKafkaOutputs,
+		common.NewSendingQueue(exporterQueueSize,
+			common.WithSizer(common.SizerBytes),
+			common.WithBatch(common.Batch{
+				MinSize:      exporterBatchMinSize,
+				MaxSize:      exporterBatchMaxSize,
+				FlushTimeout: exporterBatchFlushTimeout,
+			}),
+		),
+	)
+}
 
-	return nil
+func (b *Builder) addElasticsearchExporters() buildComponentFunc {
+	return b.AddElasticsearchExporters(b.Reader, pipelines.LogPipelineElasticsearchOutputs,
+		common.NewSendingQueue(exporterQueueSize,
+			common.WithSizer(common.SizerBytes),
+			common.WithBatch(common.Batch{
+				MinSize:      exporterBatchMinSize,
+				MaxSize:      exporterBatchMaxSize,
+				FlushTimeout: exporterBatchFlushTimeout,
+			}),
+		),
+	)
 }
 
-func shouldEnableOAuth2(tp *telemetryv1beta1.LogPipeline) bool {
-	return tp.Spec.Output.OTLP.Authentication != nil && tp.Spec.Output.OTLP.Authentication.OAuth2 != nil
+func (b *Builder) addOAuth2Extensions(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error {
+	return b.AddOAuth2Extensions(ctx, b.Reader, pipelines.LogPipelineOutputs(pipeline))
 }
 
 func formatLogServicePipelineID(lp *telemetryv1beta1.LogPipeline) string {
@@ -286,6 +404,10 @@ func formatFileLogReceiverID(lp *telemetryv1beta1.LogPipeline) string {
 	return common.ComponentIDFileLogReceiver(lp.Name)
 }
 
+func formatRedactionProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.LogPipelineRef(lp))
+}
+
 func formatUserDefinedTransformProcessorID(lp *telemetryv1beta1.LogPipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.LogPipelineRef(lp))
 }
@@ -294,6 +416,18 @@ func formatUserDefinedFilterProcessorID(lp *telemetryv1beta1.LogPipeline) string
 	return common.ComponentIDUserDefinedFilterProcessor(pipelines.LogPipelineRef(lp))
 }
 
-func formatOTLPExporterID(lp *telemetryv1beta1.LogPipeline) string {
-	return common.ComponentIDOTLPExporter(lp.Spec.Output.OTLP.Protocol, pipelines.LogPipelineRef(lp))
+func formatPodSelectorFilterProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDPodSelectorFilterProcessor(pipelines.LogPipelineRef(lp))
+}
+
+func formatMinSeverityFilterProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDMinSeverityFilterProcessor(pipelines.LogPipelineRef(lp))
+}
+
+func formatDeduplicateProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDLogDedupProcessor(pipelines.LogPipelineRef(lp))
+}
+
+func formatElasticsearchIndexProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDElasticsearchIndexProcessor(pipelines.LogPipelineRef(lp))
 }
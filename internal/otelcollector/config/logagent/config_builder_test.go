@@ -4,9 +4,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
@@ -36,6 +38,125 @@ func TestBuildConfig(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:           "pipeline with multiline rules",
+			goldenFileName: "multiline.yaml",
+			pipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test").
+					WithRuntimeInput(true).
+					WithKeepOriginalBody(true).
+					WithMultiline(
+						telemetryv1beta1.LogPipelineMultiline{Containers: []string{"python-app"}, Preset: telemetryv1beta1.MultilinePresetPython},
+						telemetryv1beta1.LogPipelineMultiline{StartPattern: `^\d{4}-\d{2}-\d{2}`, ContinuationPattern: `^\s+at `},
+					).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+			},
+		},
+		{
+			name:           "pipeline with combined log parser",
+			goldenFileName: "parser-combined.yaml",
+			pipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test").
+					WithRuntimeInput(true).
+					WithKeepOriginalBody(true).
+					WithParser(&telemetryv1beta1.LogPipelineParser{Format: telemetryv1beta1.ParserFormatCombined}).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with min severity",
+			goldenFileName: "min-severity.yaml",
+			pipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(true).
+					WithMinSeverity(&telemetryv1beta1.LogPipelineMinSeverity{
+						Level: telemetryv1beta1.LogSeverityInfo,
+						Overrides: []telemetryv1beta1.LogPipelineMinSeverityOverride{
+							{Namespaces: telemetryv1beta1.NamespaceSelector{Include: []string{"debugging"}}, Level: telemetryv1beta1.LogSeverityTrace},
+							{Namespaces: telemetryv1beta1.NamespaceSelector{Exclude: []string{"production"}}, Level: telemetryv1beta1.LogSeverityDebug},
+						},
+					}).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+				testutils.NewLogPipelineBuilder().
+					WithName("test2").
+					WithRuntimeInput(true).
+					WithMinSeverity(&telemetryv1beta1.LogPipelineMinSeverity{Level: telemetryv1beta1.LogSeverityTrace}).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with redaction",
+			goldenFileName: "redaction.yaml",
+			pipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(true).
+					WithRedaction(&telemetryv1beta1.RedactionSpec{
+						Detectors:   []telemetryv1beta1.RedactionDetector{telemetryv1beta1.RedactionDetectorCreditCard, telemetryv1beta1.RedactionDetectorEmail},
+						Patterns:    []string{`customer-[0-9]{6}`},
+						AllowedKeys: []string{"user.email"},
+					}).
+					WithTransform(telemetryv1beta1.TransformSpec{
+						Statements: []string{"set(attributes[\"user.email\"], \"redacted\")"},
+					}).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with deduplication",
+			goldenFileName: "deduplicate.yaml",
+			pipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(true).
+					WithDeduplicate(&telemetryv1beta1.LogPipelineDeduplicate{}).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+				testutils.NewLogPipelineBuilder().
+					WithName("test2").
+					WithRuntimeInput(true).
+					WithDeduplicate(&telemetryv1beta1.LogPipelineDeduplicate{
+						Interval: &metav1.Duration{Duration: time.Minute},
+					}).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+			},
+		},
+		{
+			name:           "pipelines with pod selectors",
+			goldenFileName: "pod-selector.yaml",
+			pipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(true).
+					WithPodSelector(&telemetryv1beta1.LogPipelinePodSelector{
+						Labels: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "foo"},
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"frontend"}},
+							},
+						},
+					}).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+				testutils.NewLogPipelineBuilder().
+					WithName("test2").
+					WithRuntimeInput(true).
+					WithPodSelector(&telemetryv1beta1.LogPipelinePodSelector{
+						Annotations: map[string]string{"example.com/logs": "enabled"},
+					}).
+					WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).
+					Build(),
+			},
+		},
 		{
 			name:              "single pipeline with otel service enrichment",
 			goldenFileName:    "service-enrichment-otel.yaml",
@@ -0,0 +1,102 @@
+package logagent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+)
+
+const (
+	operatorMultilineRouter = "multiline-router"
+	attributeKeyLogFilePath = "log.file.path"
+)
+
+// multilinePresetContinuationPatterns matches the continuation lines of the stack traces of a language runtime: indented and empty lines,
+// and the runtime-specific lines that are not indented. Exception headers count as continuation lines too,
+// so that they are kept together with the log line reporting the error.
+var multilinePresetContinuationPatterns = map[telemetryv1beta1.MultilinePreset]string{
+	telemetryv1beta1.MultilinePresetJava:   `^(\s|$|Caused by:|([\w$]+\.)+[\w$]*(Exception|Error|Throwable)\b)`,
+	telemetryv1beta1.MultilinePresetPython: `^(\s|$|Traceback \(most recent call last\):|During handling of the above exception|The above exception was the direct cause|(\w+\.)*\w+(Error|Exception|Warning|Exit|Interrupt)\b)`,
+	telemetryv1beta1.MultilinePresetGo:     `^(\s|$|goroutine \d+ \[|created by |\S+\(.*\)$|\[signal |exit status )`,
+	telemetryv1beta1.MultilinePresetDotNet: "^(\\s|$|--- End of |([\\w`]+\\.)+[\\w`]*Exception\\b)",
+}
+
+// makeMultilineOperators routes the logs of each container to the recombine operator of the first multiline rule selecting the container.
+// The recombine operators assemble the lines of a log per log file, so lines of different containers are never mixed.
+func makeMultilineOperators(rules []telemetryv1beta1.LogPipelineMultiline) []Operator {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	router := Operator{
+		ID:      operatorMultilineRouter,
+		Type:    Router,
+		Default: operatorBodyRouter,
+	}
+
+	recombiners := make([]Operator, 0, len(rules))
+
+	for i, rule := range rules {
+		id := fmt.Sprintf("multiline-recombine-%d", i)
+
+		router.Routes = append(router.Routes, Route{
+			Expression: containerNameExpression(rule.Containers),
+			Output:     id,
+		})
+
+		recombiners = append(recombiners, Operator{
+			ID:               id,
+			Type:             Recombine,
+			CombineField:     "body",
+			IsFirstEntry:     isFirstEntryExpression(rule),
+			SourceIdentifier: common.Attribute(attributeKeyLogFilePath),
+			Output:           operatorBodyRouter,
+		})
+	}
+
+	return append([]Operator{router}, recombiners...)
+}
+
+func containerNameExpression(containers []string) string {
+	if len(containers) == 0 {
+		return "true"
+	}
+
+	quoted := make([]string, 0, len(containers))
+	for _, container := range containers {
+		quoted = append(quoted, strconv.Quote(container))
+	}
+
+	return fmt.Sprintf("resource[\"k8s.container.name\"] in [%s]", strings.Join(quoted, ", "))
+}
+
+// isFirstEntryExpression returns the expression deciding whether a line starts a new log record.
+// Lines not matching the continuation pattern start a new log record, as well as lines matching the start pattern.
+func isFirstEntryExpression(rule telemetryv1beta1.LogPipelineMultiline) string {
+	startPattern, continuationPattern := rule.StartPattern, rule.ContinuationPattern
+	if rule.Preset != "" {
+		startPattern, continuationPattern = "", multilinePresetContinuationPatterns[rule.Preset]
+	}
+
+	switch {
+	case startPattern != "" && continuationPattern != "":
+		return fmt.Sprintf("%s or not (%s)", bodyMatches(startPattern), bodyMatches(continuationPattern))
+	case continuationPattern != "":
+		return fmt.Sprintf("not (%s)", bodyMatches(continuationPattern))
+	default:
+		return bodyMatches(startPattern)
+	}
+}
+
+// bodyMatches returns an expression matching the body against a regular expression, which is quoted to be used as string literal
+func bodyMatches(pattern string) string {
+	return fmt.Sprintf("body matches %s", escapeDollarSigns(strconv.Quote(pattern)))
+}
+
+// escapeDollarSigns escapes user-provided values, which would otherwise be subject to environment variable expansion in the collector configuration.
+func escapeDollarSigns(value string) string {
+	return strings.ReplaceAll(value, "$", "$$")
+}
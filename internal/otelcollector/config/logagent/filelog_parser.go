@@ -0,0 +1,147 @@
+package logagent
+
+import (
+	"time"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+)
+
+const (
+	attributeKeyTime                   = "time"
+	attributeKeyKlogSeverity           = "klog_severity"
+	attributeKeyHTTPResponseStatusCode = "http_response_status_code"
+
+	timeLayoutTypeGo       = "gotime"
+	timeLayoutTypeStrptime = "strptime"
+	combinedTimeLayout     = "%d/%b/%Y:%H:%M:%S %z"
+)
+
+// logfmtPattern matches logs consisting of key=value pairs only, where values containing whitespace are quoted.
+const logfmtPattern = `^\s*[\w.\-/]+=(?:"(?:[^"\\]|\\.)*"|\S*)(?:\s+[\w.\-/]+=(?:"(?:[^"\\]|\\.)*"|\S*))*\s*$`
+
+// combinedPattern matches the Apache and nginx combined log format. The whole log line is captured as message, so that it is kept as body.
+const combinedPattern = `^(?P<message>(?P<client_address>\S+) \S+ (?P<user_name>\S+) \[(?P<time>[^\]]+)\] "(?P<http_request_method>[A-Z]+) (?P<url_path>\S+) (?P<network_protocol>[^"]+)" (?P<http_response_status_code>\d{3}) (?P<http_response_body_size>\d+|-)(?: "(?P<http_request_referer>[^"]*)" "(?P<user_agent_original>[^"]*)")?)$`
+
+// klogPattern matches the log format of the Kubernetes components. The header has no year, so the timestamp of the container runtime is kept.
+const klogPattern = `(?s)^(?P<klog_severity>[IWEF])\d{4} \d{2}:\d{2}:\d{2}\.\d{6}\s+(?P<thread_id>\d+) (?P<code_file_path>[^:\]]+):(?P<code_line_number>\d+)\] (?P<message>.*)$`
+
+// makeParserOperators returns the route of the body router to the parser of the given format, and the operators parsing the logs in that format.
+// The parsed attributes are processed by the same operators as parsed JSON logs, so that message, severity, and trace context are mapped the same way.
+func makeParserOperators(parser *telemetryv1beta1.LogPipelineParser) (*Route, []Operator) {
+	if parser == nil {
+		return nil, nil
+	}
+
+	var (
+		routePattern string
+		operators    []Operator
+	)
+
+	switch parser.Format {
+	case telemetryv1beta1.ParserFormatLogfmt:
+		routePattern = logfmtPattern
+		operators = []Operator{
+			makeLogfmtParser(),
+			makeTimeParser(timeLayoutTypeGo, time.RFC3339Nano),
+		}
+	case telemetryv1beta1.ParserFormatCombined:
+		routePattern = combinedPattern
+		operators = []Operator{
+			makeRegexParser("combined-parser", combinedPattern),
+			makeSeverityParserFromHTTPStatus(),
+			makeTimeParser(timeLayoutTypeStrptime, combinedTimeLayout),
+		}
+	case telemetryv1beta1.ParserFormatKlog:
+		routePattern = klogPattern
+		operators = []Operator{
+			makeRegexParser("klog-parser", klogPattern),
+			makeSeverityParserFromKlogSeverity(),
+			makeRemoveKlogSeverity(),
+		}
+	case telemetryv1beta1.ParserFormatRegex:
+		routePattern = parser.Regex
+		operators = []Operator{
+			makeRegexParser("regex-parser", escapeDollarSigns(parser.Regex)),
+			makeTimeParser(timeLayoutTypeGo, time.RFC3339Nano),
+		}
+	default:
+		return nil, nil
+	}
+
+	return &Route{Expression: bodyMatches(routePattern), Output: operators[0].ID}, operators
+}
+
+// parse body as logfmt and move the key-value pairs to attributes
+func makeLogfmtParser() Operator {
+	return Operator{
+		ID:        "logfmt-parser",
+		Type:      KeyValueParser,
+		ParseFrom: "body",
+		ParseTo:   "attributes",
+	}
+}
+
+// parse body with a regular expression and move the named capture groups to attributes
+func makeRegexParser(id, regex string) Operator {
+	return Operator{
+		ID:        id,
+		Type:      RegexParser,
+		Regex:     regex,
+		ParseFrom: "body",
+		ParseTo:   "attributes",
+	}
+}
+
+// parse timestamp from time attribute, logs with an unexpected layout keep the timestamp of the container runtime
+func makeTimeParser(layoutType, layout string) Operator {
+	return Operator{
+		ID:         "parse-time",
+		Type:       TimeParser,
+		ParseFrom:  common.Attribute(attributeKeyTime),
+		LayoutType: layoutType,
+		Layout:     layout,
+		IfExpr:     common.AttributeIsNotNil(attributeKeyTime),
+		OnError:    "send_quiet",
+	}
+}
+
+// parse severity from the HTTP response status code of an access log
+func makeSeverityParserFromHTTPStatus() Operator {
+	return Operator{
+		ID:        "parse-http-status",
+		Type:      SeverityParser,
+		ParseFrom: common.Attribute(attributeKeyHTTPResponseStatusCode),
+		Mapping: map[string]any{
+			"info":  []string{"2xx", "3xx"},
+			"warn":  "4xx",
+			"error": "5xx",
+		},
+		OverwriteText: new(true),
+	}
+}
+
+// parse severity from the severity letter of the klog header
+func makeSeverityParserFromKlogSeverity() Operator {
+	return Operator{
+		ID:        "parse-klog-severity",
+		Type:      SeverityParser,
+		ParseFrom: common.Attribute(attributeKeyKlogSeverity),
+		Mapping: map[string]any{
+			"info":  "I",
+			"warn":  "W",
+			"error": "E",
+			"fatal": "F",
+		},
+		OverwriteText: new(true),
+	}
+}
+
+// Remove klog severity attribute after parsing severity
+func makeRemoveKlogSeverity() Operator {
+	return Operator{
+		ID:    "remove-klog-severity",
+		Type:  Remove,
+		Field: common.Attribute(attributeKeyKlogSeverity),
+	}
+}
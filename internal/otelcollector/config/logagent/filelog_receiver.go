@@ -27,7 +27,8 @@ const (
 	attributeKeyTraceFlags  = "trace_flags"
 	attributeKeyTraceParent = "traceparent"
 
-	operatorNoop = "noop"
+	operatorNoop       = "noop"
+	operatorBodyRouter = "body-router"
 )
 
 func fileLogReceiver(lp *telemetryv1beta1.LogPipeline, collectAgentLogs bool) *FileLogReceiverConfig {
@@ -129,16 +130,30 @@ func makeOperators(lp *telemetryv1beta1.LogPipeline) []Operator {
 		makeContainerParser(),
 		makeMoveToLogStream(),
 		makeDropAttributeLogTag(),
-		makeBodyRouter(),
-		makeJSONParser(),
 	}
+
+	operators = append(operators, makeMultilineOperators(lp.Spec.Input.Runtime.Multiline)...)
+
+	bodyOperator := makeRemoveBody()
 	if keepOriginalBody {
-		operators = append(operators, makeMoveBodyToLogOriginal())
-	} else {
-		operators = append(operators, makeRemoveBody())
+		bodyOperator = makeMoveBodyToLogOriginal()
+	}
+
+	parserRoute, parserOperators := makeParserOperators(lp.Spec.Input.Runtime.Parser)
+
+	bodyRouter := makeBodyRouter()
+	jsonParser := makeJSONParser()
+
+	if parserRoute != nil {
+		bodyRouter.Routes = append(bodyRouter.Routes, *parserRoute)
+		// skip the operators of the additional parser for JSON logs
+		jsonParser.Output = bodyOperator.ID
 	}
 
+	operators = append(operators, bodyRouter, jsonParser)
+	operators = append(operators, parserOperators...)
 	operators = append(operators,
+		bodyOperator,
 		makeMoveMessageToBody(),
 		makeMoveMsgToBody(),
 		makeSeverityParserFromLevel(),
@@ -190,9 +205,9 @@ func makeDropAttributeLogTag() Operator {
 func makeBodyRouter() Operator {
 	regexPattern := `^{.*}$`
 
-	// If body is not a JSON document, then skip all operators as they are all based on a parsed record and go to noop
+	// If body is not a JSON document or a log in the format of the additional parser, then skip all operators as they are all based on a parsed record and go to noop
 	return Operator{
-		ID:      "body-router",
+		ID:      operatorBodyRouter,
 		Type:    Router,
 		Default: operatorNoop,
 		Routes: []Route{
@@ -2,6 +2,7 @@ package logagent
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -91,6 +92,245 @@ func TestReceiverCreator(t *testing.T) {
 	}
 }
 
+func TestMakeMultilineOperators(t *testing.T) {
+	require.Nil(t, makeMultilineOperators(nil))
+
+	operators := makeMultilineOperators([]telemetryv1beta1.LogPipelineMultiline{
+		{Containers: []string{"app", "sidecar"}, Preset: telemetryv1beta1.MultilinePresetJava},
+		{StartPattern: `^\d{4}-\d{2}-\d{2}`},
+	})
+
+	expectedOperators := []Operator{
+		{
+			ID:      "multiline-router",
+			Type:    "router",
+			Default: "body-router",
+			Routes: []Route{
+				{Expression: "resource[\"k8s.container.name\"] in [\"app\", \"sidecar\"]", Output: "multiline-recombine-0"},
+				{Expression: "true", Output: "multiline-recombine-1"},
+			},
+		},
+		{
+			ID:               "multiline-recombine-0",
+			Type:             "recombine",
+			CombineField:     "body",
+			IsFirstEntry:     `not (body matches "^(\\s|$$|Caused by:|([\\w$$]+\\.)+[\\w$$]*(Exception|Error|Throwable)\\b)")`,
+			SourceIdentifier: "attributes[\"log.file.path\"]",
+			Output:           "body-router",
+		},
+		{
+			ID:               "multiline-recombine-1",
+			Type:             "recombine",
+			CombineField:     "body",
+			IsFirstEntry:     `body matches "^\\d{4}-\\d{2}-\\d{2}"`,
+			SourceIdentifier: "attributes[\"log.file.path\"]",
+			Output:           "body-router",
+		},
+	}
+	require.Equal(t, expectedOperators, operators)
+}
+
+func TestIsFirstEntryExpression(t *testing.T) {
+	tt := []struct {
+		name     string
+		rule     telemetryv1beta1.LogPipelineMultiline
+		expected string
+	}{
+		{
+			name:     "start pattern",
+			rule:     telemetryv1beta1.LogPipelineMultiline{StartPattern: `^\[`},
+			expected: `body matches "^\\["`,
+		},
+		{
+			name:     "continuation pattern",
+			rule:     telemetryv1beta1.LogPipelineMultiline{ContinuationPattern: `^\s+`},
+			expected: `not (body matches "^\\s+")`,
+		},
+		{
+			name:     "start and continuation pattern",
+			rule:     telemetryv1beta1.LogPipelineMultiline{StartPattern: `^'INFO'`, ContinuationPattern: `^\s+`},
+			expected: `body matches "^'INFO'" or not (body matches "^\\s+")`,
+		},
+		{
+			name:     "dollar signs are escaped",
+			rule:     telemetryv1beta1.LogPipelineMultiline{StartPattern: `^\d+$`, ContinuationPattern: `^${`},
+			expected: `body matches "^\\d+$$" or not (body matches "^$${")`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isFirstEntryExpression(tc.rule))
+		})
+	}
+}
+
+// TestMultilinePresets checks that the lines of typical stack traces are assembled into a single log record,
+// while the preceding and following log lines stay separate
+func TestMultilinePresets(t *testing.T) {
+	tt := []struct {
+		preset   telemetryv1beta1.MultilinePreset
+		lines    []string
+		expected []bool
+	}{
+		{
+			preset: telemetryv1beta1.MultilinePresetJava,
+			lines: []string{
+				"2024-01-01 12:00:00 ERROR Request failed",
+				"java.lang.IllegalStateException: boom",
+				"\tat com.example.Service.handle(Service.java:42)",
+				"Caused by: java.io.IOException: closed",
+				"\t... 12 more",
+				"2024-01-01 12:00:01 INFO Next request",
+			},
+			expected: []bool{true, false, false, false, false, true},
+		},
+		{
+			preset: telemetryv1beta1.MultilinePresetPython,
+			lines: []string{
+				"ERROR:root:Request failed",
+				"Traceback (most recent call last):",
+				"  File \"app.py\", line 3, in <module>",
+				"    raise ValueError(\"boom\")",
+				"ValueError: boom",
+				"INFO:root:Next request",
+			},
+			expected: []bool{true, false, false, false, false, true},
+		},
+		{
+			preset: telemetryv1beta1.MultilinePresetGo,
+			lines: []string{
+				"panic: runtime error: index out of range [3] with length 3",
+				"",
+				"goroutine 1 [running]:",
+				"main.main()",
+				"\t/app/main.go:8 +0x1d",
+				"exit status 2",
+				"starting server (port 8080)",
+			},
+			expected: []bool{true, false, false, false, false, false, true},
+		},
+		{
+			preset: telemetryv1beta1.MultilinePresetDotNet,
+			lines: []string{
+				"fail: Microsoft.AspNetCore.Server.Kestrel[13]",
+				"System.InvalidOperationException: boom",
+				" ---> System.IO.IOException: closed",
+				"   at Example.Service.Handle() in /app/Service.cs:line 42",
+				"--- End of inner exception stack trace ---",
+				"info: Microsoft.Hosting.Lifetime[0]",
+			},
+			expected: []bool{true, false, false, false, false, true},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(string(tc.preset), func(t *testing.T) {
+			continuation := regexp.MustCompile(multilinePresetContinuationPatterns[tc.preset])
+
+			for i, line := range tc.lines {
+				require.Equal(t, tc.expected[i], !continuation.MatchString(line), "line %q", line)
+			}
+		})
+	}
+}
+
+func TestMakeParserOperators(t *testing.T) {
+	route, operators := makeParserOperators(nil)
+	require.Nil(t, route)
+	require.Nil(t, operators)
+
+	route, operators = makeParserOperators(&telemetryv1beta1.LogPipelineParser{
+		Format: telemetryv1beta1.ParserFormatRegex,
+		Regex:  `^(?P<level>\w+) (?P<message>.*)$`,
+	})
+
+	require.Equal(t, &Route{Expression: `body matches "^(?P<level>\\w+) (?P<message>.*)$$"`, Output: "regex-parser"}, route)
+	require.Equal(t, []Operator{
+		{
+			ID:        "regex-parser",
+			Type:      "regex_parser",
+			Regex:     `^(?P<level>\w+) (?P<message>.*)$$`,
+			ParseFrom: "body",
+			ParseTo:   "attributes",
+		},
+		{
+			ID:         "parse-time",
+			Type:       "time_parser",
+			ParseFrom:  "attributes[\"time\"]",
+			LayoutType: "gotime",
+			Layout:     "2006-01-02T15:04:05.999999999Z07:00",
+			IfExpr:     "attributes[\"time\"] != nil",
+			OnError:    "send_quiet",
+		},
+	}, operators)
+
+	for _, format := range []telemetryv1beta1.ParserFormat{
+		telemetryv1beta1.ParserFormatLogfmt,
+		telemetryv1beta1.ParserFormatCombined,
+		telemetryv1beta1.ParserFormatKlog,
+	} {
+		route, operators = makeParserOperators(&telemetryv1beta1.LogPipelineParser{Format: format})
+		require.NotNil(t, route, "format %s", format)
+		require.Equal(t, operators[0].ID, route.Output, "format %s", format)
+	}
+}
+
+func TestParserPatterns(t *testing.T) {
+	tt := []struct {
+		name     string
+		pattern  string
+		line     string
+		expected map[string]string
+	}{
+		{
+			name:    "logfmt",
+			pattern: logfmtPattern,
+			line:    `time=2024-01-01T12:00:00Z level=info msg="request done" duration=12ms`,
+		},
+		{
+			name:    "combined",
+			pattern: combinedPattern,
+			line:    `10.0.0.1 - frank [10/Oct/2024:13:55:36 -0700] "GET /index.html HTTP/1.1" 404 2326 "http://example.com/" "curl/8.0"`,
+			expected: map[string]string{
+				"client_address":            "10.0.0.1",
+				"user_name":                 "frank",
+				"time":                      "10/Oct/2024:13:55:36 -0700",
+				"http_request_method":       "GET",
+				"url_path":                  "/index.html",
+				"http_response_status_code": "404",
+				"user_agent_original":       "curl/8.0",
+			},
+		},
+		{
+			name:    "klog",
+			pattern: klogPattern,
+			line:    `E0102 15:04:05.123456   12345 controller.go:123] "Reconcile failed" err="boom"`,
+			expected: map[string]string{
+				"klog_severity":    "E",
+				"thread_id":        "12345",
+				"code_file_path":   "controller.go",
+				"code_line_number": "123",
+				"message":          `"Reconcile failed" err="boom"`,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			re := regexp.MustCompile(tc.pattern)
+			matches := re.FindStringSubmatch(tc.line)
+			require.NotNil(t, matches)
+
+			for name, value := range tc.expected {
+				require.Equal(t, value, matches[re.SubexpIndex(name)], "capture group %s", name)
+			}
+
+			require.False(t, re.MatchString(`{"level":"info","msg":"request done"}`))
+		})
+	}
+}
+
 func TestMakeContainerParser(t *testing.T) {
 	cp := makeContainerParser()
 	expectedContainerParser := Operator{
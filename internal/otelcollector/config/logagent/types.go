@@ -15,6 +15,12 @@ type FileLogReceiverConfig struct {
 	Operators       []Operator            `yaml:"operators,omitempty"`
 }
 
+type LogDedupProcessorConfig struct {
+	Interval          string   `yaml:"interval"`
+	LogCountAttribute string   `yaml:"log_count_attribute"`
+	ExcludeFields     []string `yaml:"exclude_fields,omitempty"`
+}
+
 type Operator struct {
 	ID                      string            `yaml:"id,omitempty"`
 	Type                    OperatorType      `yaml:"type,omitempty"`
@@ -34,6 +40,14 @@ type Operator struct {
 	Routes                  []Route           `yaml:"routes,omitempty"`
 	Default                 string            `yaml:"default,omitempty"`
 	Output                  string            `yaml:"output,omitempty"`
+	CombineField            string            `yaml:"combine_field,omitempty"`
+	IsFirstEntry            string            `yaml:"is_first_entry,omitempty"`
+	SourceIdentifier        string            `yaml:"source_identifier,omitempty"`
+	LayoutType              string            `yaml:"layout_type,omitempty"`
+	Layout                  string            `yaml:"layout,omitempty"`
+	Mapping                 map[string]any    `yaml:"mapping,omitempty"`
+	OverwriteText           *bool             `yaml:"overwrite_text,omitempty"`
+	OnError                 string            `yaml:"on_error,omitempty"`
 }
 
 type OperatorType string
@@ -48,6 +62,9 @@ const (
 	Noop           OperatorType = "noop"
 	JsonParser     OperatorType = "json_parser"
 	Container      OperatorType = "container"
+	Recombine      OperatorType = "recombine"
+	KeyValueParser OperatorType = "key_value_parser"
+	TimeParser     OperatorType = "time_parser"
 )
 
 type TraceAttribute struct {
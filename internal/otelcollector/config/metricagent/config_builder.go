@@ -3,11 +3,13 @@ package metricagent
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1beta1 "github.com/kyma-project/telemetry-manager/apis/operator/v1beta1"
@@ -15,6 +17,8 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
 	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
 	telemetryutils "github.com/kyma-project/telemetry-manager/internal/utils/telemetry"
 )
@@ -30,8 +34,18 @@ const (
 	daemonsetMetricPattern      = `^k8s[.]daemonset[.].*`
 	statefulsetMetricPattern    = `^k8s[.]statefulset[.].*`
 	jobMetricPattern            = `^k8s[.]job[.].*`
+	hostCPUMetricPattern        = `^system[.]cpu[.]time$`
+	hostMemoryMetricPattern     = `^system[.]memory[.].*`
+	hostDiskMetricPattern       = `^system[.]disk[.].*`
+	hostFilesystemMetricPattern = `^system[.]filesystem[.].*`
+	hostNetworkMetricPattern    = `^system[.]network[.].*`
+	hostLoadMetricPattern       = `^system[.]cpu[.]load_average[.].*`
+	hostMetricsScopePattern     = `^github[.]com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/`
 )
 
+// sendingQueueStorageDirectory is the directory in which the persistent sending queues of the exporters are stored
+var sendingQueueStorageDirectory = filepath.Join(otelcollector.SendingQueueVolumePath, names.MetricAgent)
+
 var diagnosticMetricNames = []string{"up", "scrape_duration_seconds", "scrape_samples_scraped", "scrape_samples_post_metric_relabeling", "scrape_series_added"}
 
 type buildComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.MetricPipeline]
@@ -61,11 +75,28 @@ type BuildOptions struct {
 
 // inputSources represents the enabled input sources for the telemetry Metric Agent.
 type inputSources struct {
-	runtime          bool
-	runtimeResources runtimeResourceSources
-	prometheus       bool
-	istio            bool
-	envoy            bool
+	runtime            bool
+	runtimeResources   runtimeResourceSources
+	prometheus         bool
+	prometheusMonitors bool
+	istio              bool
+	envoy              bool
+	host               bool
+	hostScrapers       hostScraperSources
+}
+
+// hostScraperSources represents the groups of host metrics for which scraping is enabled.
+type hostScraperSources struct {
+	cpu        bool
+	memory     bool
+	disk       bool
+	filesystem bool
+	network    bool
+	load       bool
+}
+
+func (h hostScraperSources) any() bool {
+	return h.cpu || h.memory || h.disk || h.filesystem || h.network || h.load
 }
 
 // runtimeResourceSources represents the resources for which runtime metrics scraping is enabled.
@@ -110,11 +141,22 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 			job:         shouldEnableRuntimeJobMetricsScraping(pipelines),
 		},
 
-		runtime:    shouldEnableRuntimeMetricsScraping(pipelines),
-		prometheus: shouldEnablePrometheusMetricsScraping(pipelines),
-		istio:      shouldEnableIstioMetricsScraping(pipelines),
-		envoy:      shouldEnableEnvoyMetricsScraping(pipelines),
+		runtime:            shouldEnableRuntimeMetricsScraping(pipelines),
+		prometheus:         shouldEnablePrometheusMetricsScraping(pipelines),
+		prometheusMonitors: shouldEnablePrometheusMonitorsScraping(pipelines),
+		istio:              shouldEnableIstioMetricsScraping(pipelines),
+		envoy:              shouldEnableEnvoyMetricsScraping(pipelines),
+		hostScrapers: hostScraperSources{
+			cpu:        shouldEnableHostScraper(pipelines, metricpipelineutils.IsHostCPUInputEnabled),
+			memory:     shouldEnableHostScraper(pipelines, metricpipelineutils.IsHostMemoryInputEnabled),
+			disk:       shouldEnableHostScraper(pipelines, metricpipelineutils.IsHostDiskInputEnabled),
+			filesystem: shouldEnableHostScraper(pipelines, metricpipelineutils.IsHostFilesystemInputEnabled),
+			network:    shouldEnableHostScraper(pipelines, metricpipelineutils.IsHostNetworkInputEnabled),
+			load:       shouldEnableHostScraper(pipelines, metricpipelineutils.IsHostLoadInputEnabled),
+		},
 	}
+	// The hostmetrics receiver requires at least one scraper, so the host input is only enabled if any pipeline collects at least one group of host metrics
+	inputs.host = inputs.hostScrapers.any()
 
 	// Input pipelines
 	pipelinesWithRuntimeInput := getPipelinesWithRuntimeInput(pipelines)
@@ -144,10 +186,25 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 	}
 
 	if inputs.prometheus {
+		var serviceMonitors, podMonitors []unstructured.Unstructured
+
+		if inputs.prometheusMonitors {
+			var err error
+			if serviceMonitors, err = b.listMonitors(ctx, ServiceMonitorGVK); err != nil {
+				return nil, nil, err
+			}
+
+			if podMonitors, err = b.listMonitors(ctx, PodMonitorGVK); err != nil {
+				return nil, nil, err
+			}
+		}
+
 		if err := b.AddServicePipeline(ctx, nil, "metrics/input-prometheus",
 			b.addPrometheusAppPodsReceiver(opts.CollectionIntervals.Prometheus),
 			b.addPrometheusAppServicesReceiver(opts, opts.CollectionIntervals.Prometheus),
+			b.addPrometheusAppMonitorsReceiver(ctx, serviceMonitors, podMonitors, opts, opts.CollectionIntervals.Prometheus),
 			b.addMemoryLimiterProcessor(),
+			b.addSetPrometheusMonitorAttributeProcessor(inputs.prometheusMonitors),
 			b.addDropServiceNameProcessor(),
 			b.addSetInstrumentationScopeToPrometheusProcessor(opts),
 			b.addSetKymaInputNameProcessor(common.InputSourcePrometheus),
@@ -175,18 +232,35 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 		}
 	}
 
+	if inputs.host {
+		if err := b.AddServicePipeline(ctx, nil, "metrics/input-host",
+			b.addHostMetricsReceiver(inputs.hostScrapers, opts.CollectionIntervals.Runtime),
+			b.addMemoryLimiterProcessor(),
+			b.addHostMetricsResourceAttributesProcessor(),
+			b.addSetInstrumentationScopeToHostProcessor(opts),
+			b.addSetKymaInputNameProcessor(common.InputSourceHost),
+			// Host metrics describe the Node and cannot be enriched with Pod metadata, so they are forwarded directly to the output pipelines.
+			b.addExporterForHostInputForwarder(),
+		); err != nil {
+			return nil, nil, fmt.Errorf("failed to add host service pipeline: %w", err)
+		}
+	}
+
 	// Enrichment pipeline
-	if err := b.AddServicePipeline(ctx, nil, enrichmentServicePipelineID,
-		b.addReceiverForInputRouter(common.ComponentIDRuntimeInputRoutingConnector, pipelinesWithRuntimeInput, inputs.runtime),
-		b.addReceiverForInputRouter(common.ComponentIDPrometheusInputRoutingConnector, pipelinesWithPrometheusInput, inputs.prometheus),
-		b.addReceiverForInputRouter(common.ComponentIDIstioInputRoutingConnector, pipelinesWithIstioInput, inputs.istio),
-		b.addDropUnknownServiceNameProcessor(opts),
-		b.addK8sAttributesProcessor(opts),
-		b.addRestoreOtelServiceAttrsProcessor(opts),
-		b.addServiceEnrichmentProcessor(opts),
-		b.addExporterForEnrichmentRouter(pipelinesWithRuntimeInput, pipelinesWithPrometheusInput, pipelinesWithIstioInput),
-	); err != nil {
-		return nil, nil, fmt.Errorf("failed to add enrichment service pipeline: %w", err)
+	// Host metrics bypass the enrichment, so the pipeline is only added if an input requiring enrichment is enabled
+	if inputs.runtime || inputs.prometheus || inputs.istio {
+		if err := b.AddServicePipeline(ctx, nil, enrichmentServicePipelineID,
+			b.addReceiverForInputRouter(common.ComponentIDRuntimeInputRoutingConnector, pipelinesWithRuntimeInput, inputs.runtime),
+			b.addReceiverForInputRouter(common.ComponentIDPrometheusInputRoutingConnector, pipelinesWithPrometheusInput, inputs.prometheus),
+			b.addReceiverForInputRouter(common.ComponentIDIstioInputRoutingConnector, pipelinesWithIstioInput, inputs.istio),
+			b.addDropUnknownServiceNameProcessor(opts),
+			b.addK8sAttributesProcessor(opts),
+			b.addRestoreOtelServiceAttrsProcessor(opts),
+			b.addServiceEnrichmentProcessor(opts),
+			b.addExporterForEnrichmentRouter(pipelinesWithRuntimeInput, pipelinesWithPrometheusInput, pipelinesWithIstioInput),
+		); err != nil {
+			return nil, nil, fmt.Errorf("failed to add enrichment service pipeline: %w", err)
+		}
 	}
 
 	// Output pipelines
@@ -195,15 +269,14 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 		runtimeInputEnabled := metricpipelineutils.IsRuntimeInputEnabled(pipeline.Spec.Input)
 		prometheusInputEnabled := metricpipelineutils.IsPrometheusInputEnabled(pipeline.Spec.Input)
 		istioInputEnabled := metricpipelineutils.IsIstioInputEnabled(pipeline.Spec.Input)
-		queueSize := common.BatchingMaxQueueSize / len(pipelines)
+		hostInputEnabled := inputs.host && metricpipelineutils.IsHostInputEnabled(pipeline.Spec.Input)
+		queueSize := common.BatchingMaxQueueSize / countOutputs(pipelines)
 
-		if shouldEnableOAuth2(&pipeline) {
-			if err := b.addOAuth2Extension(ctx, &pipeline); err != nil {
-				return nil, nil, err
-			}
+		if err := b.addOAuth2Extensions(ctx, &pipeline); err != nil {
+			return nil, nil, err
 		}
 
-		if err := b.AddServicePipeline(ctx, &pipeline, outputPipelineID,
+		outputFuncs := []buildComponentFunc{
 			// Receivers
 			// Metrics are received from either the enrichment pipeline or directly from input pipelines,
 			// depending on whether they have the skip enrichment attribute set.
@@ -211,6 +284,7 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 			b.addReceiverForInputRouter(common.ComponentIDRuntimeInputRoutingConnector, pipelinesWithRuntimeInput, runtimeInputEnabled),
 			b.addReceiverForInputRouter(common.ComponentIDPrometheusInputRoutingConnector, pipelinesWithPrometheusInput, prometheusInputEnabled),
 			b.addReceiverForInputRouter(common.ComponentIDIstioInputRoutingConnector, pipelinesWithIstioInput, istioInputEnabled),
+			b.addReceiverForHostInputForwarder(hostInputEnabled),
 			// Runtime resource filters
 			b.addDropRuntimePodMetricsProcessor(pipeline.Name),
 			b.addDropRuntimeContainerMetricsProcessor(pipeline.Name),
@@ -221,8 +295,12 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 			b.addDropRuntimeStatefulSetMetricsProcessor(pipeline.Name),
 			b.addDropRuntimeJobMetricsProcessor(pipeline.Name),
 			b.addDropAdditionalRuntimeMetricsProcessor(runtimeAdditionalMetrics, pipeline.Name),
+			// Host metric filters
+			b.addDropHostMetricsProcessor(pipeline.Name),
+			b.addDropHostMountPointsProcessor(pipeline.Name),
 			// Diagnostic metric filters
 			b.addDropPrometheusDiagnosticMetricsProcessor(),
+			b.addDropPrometheusMonitorMetricsProcessor(inputs.prometheusMonitors),
 			b.addDropIstioDiagnosticMetricsProcessor(),
 			// Istio envoy metrics
 			b.addDropEnvoyMetricsIfDisabledProcessor(),
@@ -236,13 +314,20 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 			// Kyma attributes are dropped before user-defined transform and filter processors
 			// to prevent user access to internal attributes.
 			b.addDropKymaAttributesProcessor(),
+			b.addRedactionProcessor(),
 			b.addUserDefinedTransformProcessor(),
 			b.addUserDefinedFilterProcessor(),
+		}
+		exportFuncs := []buildComponentFunc{
 			b.addCumulativeToDeltaProcessor(opts),
 			b.addBatchProcessor(), // always last
-			// OTLP exporter
-			b.addOTLPExporter(queueSize),
-		); err != nil {
+			// Exporters
+			b.addOTLPExporters(queueSize),
+			b.addKafkaExporters(queueSize),
+			b.addPrometheusRemoteWriteExporters(queueSize),
+		}
+
+		if err := b.addOutputServicePipelines(ctx, &pipeline, outputPipelineID, outputFuncs, exportFuncs); err != nil {
 			return nil, nil, fmt.Errorf("failed to add enrichment service pipeline: %w", err)
 		}
 	}
@@ -250,6 +335,16 @@ func (b *Builder) Build(ctx context.Context, pipelines []telemetryv1beta1.Metric
 	return b.Config, b.EnvVars, nil
 }
 
+// addOutputServicePipelines adds the output service pipeline of a metric pipeline.
+// If the pipeline has aggregations, the metrics to be aggregated take a detour through an aggregation service pipeline, which merges their resources.
+func (b *Builder) addOutputServicePipelines(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline, outputPipelineID string, outputFuncs, exportFuncs []buildComponentFunc) error {
+	if len(pipeline.Spec.Aggregations) > 0 {
+		return b.AddMetricAggregationServicePipelines(ctx, pipeline, pipelines.MetricPipelineRef(pipeline), pipeline.Spec.Aggregations, outputPipelineID, outputFuncs, exportFuncs)
+	}
+
+	return b.AddServicePipeline(ctx, pipeline, outputPipelineID, append(outputFuncs, exportFuncs...)...)
+}
+
 // Receiver builders
 
 func (b *Builder) addK8sClusterReceiver(runtimeResources runtimeResourceSources, k8sClusterAdditionalMetrics []string, collectionInterval time.Duration) buildComponentFunc {
@@ -270,6 +365,15 @@ func (b *Builder) addKubeletStatsReceiver(runtimeResources runtimeResourceSource
 	)
 }
 
+func (b *Builder) addHostMetricsReceiver(hostScrapers hostScraperSources, collectionInterval time.Duration) buildComponentFunc {
+	return b.AddReceiver(
+		b.StaticComponentID(common.ComponentIDHostMetricsReceiver),
+		func(*telemetryv1beta1.MetricPipeline) any {
+			return hostMetricsReceiver(hostScrapers, collectionInterval)
+		},
+	)
+}
+
 func (b *Builder) addPrometheusAppPodsReceiver(collectionInterval time.Duration) buildComponentFunc {
 	return b.AddReceiver(
 		b.StaticComponentID(common.ComponentIDPrometheusAppPodsReceiver),
@@ -288,6 +392,20 @@ func (b *Builder) addPrometheusAppServicesReceiver(opts BuildOptions, collection
 	)
 }
 
+func (b *Builder) addPrometheusAppMonitorsReceiver(ctx context.Context, serviceMonitors, podMonitors []unstructured.Unstructured, opts BuildOptions, collectionInterval time.Duration) buildComponentFunc {
+	return b.AddReceiver(
+		b.StaticComponentID(common.ComponentIDPrometheusAppMonitorsReceiver),
+		func(*telemetryv1beta1.MetricPipeline) any {
+			config := prometheusMonitorsReceiverConfig(ctx, serviceMonitors, podMonitors, opts, collectionInterval)
+			if config == nil {
+				return nil // No ServiceMonitor or PodMonitor endpoints, no receiver needed
+			}
+
+			return config
+		},
+	)
+}
+
 func (b *Builder) addPrometheusIstioReceiver(envoyMetricsEnabled bool, collectionInterval time.Duration) buildComponentFunc {
 	return b.AddReceiver(
 		b.StaticComponentID(common.ComponentIDPrometheusIstioReceiver),
@@ -349,6 +467,21 @@ func (b *Builder) addDropServiceNameProcessor() buildComponentFunc {
 	)
 }
 
+// addSetPrometheusMonitorAttributeProcessor marks metrics scraped from ServiceMonitor and PodMonitor targets
+// based on the job name, which is only available in the service.name attribute before it is dropped.
+func (b *Builder) addSetPrometheusMonitorAttributeProcessor(prometheusMonitorsEnabled bool) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDSetPrometheusMonitorAttributeProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !prometheusMonitorsEnabled {
+				return nil
+			}
+
+			return setPrometheusMonitorAttributeProcessor()
+		},
+	)
+}
+
 func (b *Builder) addSetInstrumentationScopeToRuntimeProcessor(opts BuildOptions) buildComponentFunc {
 	return b.AddProcessor(
 		b.StaticComponentID(common.ComponentIDSetInstrumentationScopeRuntimeProcessor),
@@ -358,6 +491,36 @@ func (b *Builder) addSetInstrumentationScopeToRuntimeProcessor(opts BuildOptions
 	)
 }
 
+// addHostMetricsResourceAttributesProcessor identifies the node, on which the host metrics are collected, by the resource attributes used for other metrics
+func (b *Builder) addHostMetricsResourceAttributesProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDHostMetricsResourceAttributesProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			return common.MetricTransformProcessor([]common.TransformProcessorStatements{{
+				Statements: []string{
+					fmt.Sprintf("set(resource.attributes[\"k8s.node.name\"], \"${%s}\")", common.EnvVarCurrentNodeName),
+				},
+			}})
+		},
+	)
+}
+
+// addSetInstrumentationScopeToHostProcessor sets the instrumentation scope of the host metrics.
+// Every scraper of the hostmetrics receiver has its own scope name, so the scope names are matched by their common prefix.
+func (b *Builder) addSetInstrumentationScopeToHostProcessor(opts BuildOptions) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDSetInstrumentationScopeHostProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			return common.MetricTransformProcessor([]common.TransformProcessorStatements{{
+				Statements: []string{
+					common.JoinWithWhere(fmt.Sprintf("set(scope.version, \"%s\")", opts.InstrumentationScopeVersion), common.IsMatch("scope.name", hostMetricsScopePattern)),
+					common.JoinWithWhere(fmt.Sprintf("set(scope.name, \"%s\")", common.InstrumentationScopeHost), common.IsMatch("scope.name", hostMetricsScopePattern)),
+				},
+			}})
+		},
+	)
+}
+
 func (b *Builder) addSetInstrumentationScopeToPrometheusProcessor(opts BuildOptions) buildComponentFunc {
 	return b.AddProcessor(
 		b.StaticComponentID(common.ComponentIDSetInstrumentationScopePrometheusProcessor),
@@ -492,6 +655,19 @@ func (b *Builder) addDropKymaAttributesProcessor() buildComponentFunc {
 	)
 }
 
+func (b *Builder) addRedactionProcessor() buildComponentFunc {
+	return b.AddProcessor(
+		formatRedactionProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Redaction == nil {
+				return nil // No redaction, no processor needed
+			}
+
+			return common.RedactionProcessor(mp.Spec.Redaction)
+		},
+	)
+}
+
 func (b *Builder) addUserDefinedTransformProcessor() buildComponentFunc {
 	return b.AddProcessor(
 		formatUserDefinedTransformProcessorID,
@@ -605,6 +781,84 @@ func namespacesConditions(namespaces []string) []string {
 	return conditions
 }
 
+// Host metric filter processors
+
+// addDropHostMetricsProcessor drops the host metrics of the groups that are disabled for the pipeline.
+// The hostmetrics receiver is shared by all pipelines and collects every group that is enabled for at least one pipeline.
+func (b *Builder) addDropHostMetricsProcessor(pipelineName string) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropHostMetricsProcessor+"-"+pipelineName),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			input := mp.Spec.Input
+			if !metricpipelineutils.IsHostInputEnabled(input) {
+				return nil
+			}
+
+			scrapers := []struct {
+				enabled bool
+				pattern string
+			}{
+				{metricpipelineutils.IsHostCPUInputEnabled(input), hostCPUMetricPattern},
+				{metricpipelineutils.IsHostMemoryInputEnabled(input), hostMemoryMetricPattern},
+				{metricpipelineutils.IsHostDiskInputEnabled(input), hostDiskMetricPattern},
+				{metricpipelineutils.IsHostFilesystemInputEnabled(input), hostFilesystemMetricPattern},
+				{metricpipelineutils.IsHostNetworkInputEnabled(input), hostNetworkMetricPattern},
+				{metricpipelineutils.IsHostLoadInputEnabled(input), hostLoadMetricPattern},
+			}
+
+			var conditions []string
+
+			for _, scraper := range scrapers {
+				if !scraper.enabled {
+					conditions = append(conditions, common.JoinWithAnd(
+						common.KymaInputNameEquals(common.InputSourceHost),
+						common.IsMatch("metric.name", scraper.pattern),
+					))
+				}
+			}
+
+			if len(conditions) == 0 {
+				return nil
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: conditions,
+				},
+			})
+		},
+	)
+}
+
+// addDropHostMountPointsProcessor drops the filesystem metrics of the mount points excluded in the pipeline.
+func (b *Builder) addDropHostMountPointsProcessor(pipelineName string) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropHostMountPointsProcessor+"-"+pipelineName),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			input := mp.Spec.Input
+			if !metricpipelineutils.IsHostInputEnabled(input) || !metricpipelineutils.IsHostFilesystemInputEnabled(input) || len(input.Host.ExcludeMountPoints) == 0 {
+				return nil
+			}
+
+			var conditions []string
+
+			for _, mountPoint := range input.Host.ExcludeMountPoints {
+				conditions = append(conditions, common.JoinWithAnd(
+					common.KymaInputNameEquals(common.InputSourceHost),
+					common.IsMatch("metric.name", hostFilesystemMetricPattern),
+					fmt.Sprintf("IsMatch(datapoint.attributes[\"mountpoint\"], %q)", mountPoint),
+				))
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: conditions,
+				},
+			})
+		},
+	)
+}
+
 // Runtime resource filter processors
 
 // addDropRuntimePodMetricsProcessor drops pod metrics from the runtime input if runtime input is enabled but pod metrics scraping is disabled.
@@ -974,6 +1228,23 @@ func (b *Builder) addDropIstioDiagnosticMetricsProcessor() buildComponentFunc {
 	)
 }
 
+func (b *Builder) addDropPrometheusMonitorMetricsProcessor(prometheusMonitorsEnabled bool) buildComponentFunc {
+	return b.AddProcessor(
+		b.StaticComponentID(common.ComponentIDDropPrometheusMonitorMetricsProcessor),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !prometheusMonitorsEnabled || !metricpipelineutils.IsPrometheusInputEnabled(mp.Spec.Input) || metricpipelineutils.IsPrometheusMonitorsInputEnabled(mp.Spec.Input) {
+				return nil
+			}
+
+			return common.MetricFilterProcessor([]telemetryv1beta1.FilterSpec{
+				{
+					Conditions: []string{common.ResourceAttributeEquals(monitorAttribute, "true")},
+				},
+			})
+		},
+	)
+}
+
 func dropDiagnosticMetricsFilterProcessor(inputSource common.InputSourceType) *common.FilterProcessorConfig {
 	var filterExpressions []string
 
@@ -1052,21 +1323,16 @@ func (b *Builder) addBatchProcessor() buildComponentFunc {
 
 // Exporter builders
 
-//nolint:mnd // all static config from here
-func (b *Builder) addOTLPExporter(queueSize int) buildComponentFunc {
-	return b.AddExporter(
-		formatOTLPExporterID,
-		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
-			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
-				b.Reader,
-				&mp.Spec.Output.OTLP.OTLPOutput,
-				pipelines.MetricPipelineRef(mp),
-				common.NewSendingQueue(queueSize),
-			)
+func (b *Builder) addOTLPExporters(queueSize int) buildComponentFunc {
+	return b.AddOTLPExporters(b.Reader, pipelines.MetricPipelineOutputs, common.NewSendingQueue(queueSize, common.WithPersistentStorage(sendingQueueStorageDirectory)))
+}
 
-			return otlpExporterBuilder.OTLPExporter(ctx)
-		},
-	)
+func (b *Builder) addKafkaExporters(queueSize int) buildComponentFunc {
+	return b.AddKafkaExporters(b.Reader, pipelines.MetricPipelineKafkaOutputs, common.NewSendingQueue(queueSize))
+}
+
+func (b *Builder) addPrometheusRemoteWriteExporters(queueSize int) buildComponentFunc {
+	return b.AddPrometheusRemoteWriteExporters(b.Reader, pipelines.MetricPipelinePrometheusRemoteWriteOutputs, common.NewSendingQueue(queueSize))
 }
 
 // Connector builders
@@ -1093,6 +1359,28 @@ func (b *Builder) addReceiverForInputRouter(componentID string, outputPipelines
 	)
 }
 
+func (b *Builder) addExporterForHostInputForwarder() buildComponentFunc {
+	return b.AddExporter(
+		b.StaticComponentID(common.ComponentIDHostInputConnector),
+		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
+			return &common.ForwardConnectorConfig{}, nil, nil
+		},
+	)
+}
+
+func (b *Builder) addReceiverForHostInputForwarder(hostInputEnabled bool) buildComponentFunc {
+	return b.AddReceiver(
+		b.StaticComponentID(common.ComponentIDHostInputConnector),
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if !hostInputEnabled {
+				return nil
+			}
+
+			return &common.ForwardConnectorConfig{}
+		},
+	)
+}
+
 func (b *Builder) addExporterForEnrichmentRouter(runtimePipelines, prometheusPipelines, istioPipelines []telemetryv1beta1.MetricPipeline) buildComponentFunc {
 	return b.AddExporter(
 		b.StaticComponentID(common.ComponentIDEnrichmentRoutingConnector),
@@ -1159,21 +1447,8 @@ func inputRoutingConnector(outputPipelineIDs []string) common.RoutingConnectorCo
 
 // Authentication extensions
 
-func (b *Builder) addOAuth2Extension(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
-	oauth2ExtensionID := common.ComponentIDOAuth2Extension(pipelines.MetricPipelineRef(pipeline))
-
-	oauth2ExtensionConfig, oauth2ExtensionEnvVars, err := common.NewOAuth2ExtensionConfigBuilder(
-		b.Reader,
-		pipeline.Spec.Output.OTLP.Authentication.OAuth2,
-		pipelines.MetricPipelineRef(pipeline),
-	).OAuth2Extension(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to build OAuth2 extension for pipeline %s: %w", pipeline.Name, err)
-	}
-
-	b.AddExtension(oauth2ExtensionID, oauth2ExtensionConfig, oauth2ExtensionEnvVars)
-
-	return nil
+func (b *Builder) addOAuth2Extensions(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
+	return b.AddOAuth2Extensions(ctx, b.Reader, pipelines.MetricPipelineOutputs(pipeline))
 }
 
 // Helper functions for formatting IDs
@@ -1191,10 +1466,6 @@ func formatOutputMetricServicePipelineID(mp *telemetryv1beta1.MetricPipeline) st
 	return fmt.Sprintf("metrics/output-%s", mp.Name)
 }
 
-func formatOTLPExporterID(pipeline *telemetryv1beta1.MetricPipeline) string {
-	return common.ComponentIDOTLPExporter(pipeline.Spec.Output.OTLP.Protocol, pipelines.MetricPipelineRef(pipeline))
-}
-
 func formatNamespaceFilterID(pipelineName string, inputSourceType common.InputSourceType) string {
 	return common.ComponentIDNamespacePerInputFilterProcessor(pipelineName, inputSourceType)
 }
@@ -1341,6 +1612,18 @@ func shouldEnableRuntimeJobMetricsScraping(pipelines []telemetryv1beta1.MetricPi
 	return false
 }
 
+// shouldEnableHostScraper returns whether any pipeline with the host input collects the group of host metrics
+func shouldEnableHostScraper(pipelines []telemetryv1beta1.MetricPipeline, isScraperEnabled func(telemetryv1beta1.MetricPipelineInput) bool) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsHostInputEnabled(input) && isScraperEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func shouldEnablePrometheusMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
 	for i := range pipelines {
 		input := pipelines[i].Spec.Input
@@ -1352,6 +1635,17 @@ func shouldEnablePrometheusMetricsScraping(pipelines []telemetryv1beta1.MetricPi
 	return false
 }
 
+func shouldEnablePrometheusMonitorsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
+	for i := range pipelines {
+		input := pipelines[i].Spec.Input
+		if metricpipelineutils.IsPrometheusInputEnabled(input) && metricpipelineutils.IsPrometheusMonitorsInputEnabled(input) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func shouldEnableIstioMetricsScraping(pipelines []telemetryv1beta1.MetricPipeline) bool {
 	for i := range pipelines {
 		input := pipelines[i].Spec.Input
@@ -1378,8 +1672,16 @@ func shouldFilterByNamespace(namespaceSelector *telemetryv1beta1.NamespaceSelect
 	return namespaceSelector != nil && (len(namespaceSelector.Include) > 0 || len(namespaceSelector.Exclude) > 0)
 }
 
-func shouldEnableOAuth2(tp *telemetryv1beta1.MetricPipeline) bool {
-	return tp.Spec.Output.OTLP.Authentication != nil && tp.Spec.Output.OTLP.Authentication.OAuth2 != nil
+// countOutputs returns the number of outputs of all pipelines, which share the available queue size.
+func countOutputs(mps []telemetryv1beta1.MetricPipeline) int {
+	count := 0
+	for i := range mps {
+		count += len(pipelines.MetricPipelineOutputs(&mps[i])) +
+			len(pipelines.MetricPipelineKafkaOutputs(&mps[i])) +
+			len(pipelines.MetricPipelinePrometheusRemoteWriteOutputs(&mps[i]))
+	}
+
+	return count
 }
 
 func getRuntimeAdditionalMetrics(pipelines []telemetryv1beta1.MetricPipeline) ([]string, []string) {
@@ -1426,6 +1728,25 @@ func dropServiceNameProcessor() *common.TransformProcessorConfig {
 	)
 }
 
+func setPrometheusMonitorAttributeProcessor() *common.TransformProcessorConfig {
+	return common.MetricTransformProcessor(
+		[]common.TransformProcessorStatements{{
+			Statements: []string{
+				common.JoinWithWhere(
+					fmt.Sprintf("set(%s, \"true\")", common.ResourceAttribute(monitorAttribute)),
+					common.JoinWithAnd(
+						common.ResourceAttributeIsNotNil("service.name"),
+						common.JoinWithOr(
+							common.ResourceAttributeHasPrefix("service.name", string(serviceMonitorKind)+"/"),
+							common.ResourceAttributeHasPrefix("service.name", string(podMonitorKind)+"/"),
+						),
+					),
+				),
+			},
+		}},
+	)
+}
+
 func insertSkipEnrichmentAttributeProcessor() *common.TransformProcessorConfig {
 	metricsToSkipEnrichment := []string{
 		"node",
@@ -1462,7 +1783,7 @@ func dropVirtualNetworkInterfacesProcessor() *common.FilterProcessorConfig {
 		{
 			Conditions: []string{common.JoinWithAnd(
 				common.IsMatch("metric.name", "^k8s.node.network.*"),
-				common.Not(common.IsMatch("datapoint.attributes[\"interface\"]", "^(eth|en).*")),
+				common.Not(common.IsMatch("datapoint.attributes[\"interface\"]", physicalNetworkInterfacesPattern)),
 			)},
 		},
 	})
@@ -1479,6 +1800,10 @@ func metricNameConditionsWithIsMatch(metrics []string) []string {
 	return conditions
 }
 
+func formatRedactionProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.MetricPipelineRef(mp))
+}
+
 func formatUserDefinedTransformProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.MetricPipelineRef(mp))
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
@@ -501,6 +502,55 @@ func TestBuildConfig(t *testing.T) {
 				}).Build(),
 			},
 		},
+		{
+			name:           "pipeline with redaction",
+			goldenFileName: "redaction.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test1").
+					WithRuntimeInput(true).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					WithRedaction(&telemetryv1beta1.RedactionSpec{
+						Detectors:   []telemetryv1beta1.RedactionDetector{telemetryv1beta1.RedactionDetectorEmail},
+						AllowedKeys: []string{"k8s.pod.name"},
+					}).Build(),
+			},
+		},
+		{
+			name:           "pipeline with aggregations",
+			goldenFileName: "aggregations.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test1").
+					WithPrometheusInput(true).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					WithAggregation(telemetryv1beta1.MetricAggregation{
+						MetricNames: []string{"http_server_requests_total"},
+						KeepLabels:  []string{"method", "status"},
+					}).Build(),
+			},
+		},
+		{
+			name:           "pipelines with host input",
+			goldenFileName: "host-input.yaml",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test1").
+					WithHostInput(true).
+					WithHostInputExcludeMountPoints("^/mnt/.*").
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("test2").
+					WithHostInput(true).
+					WithHostInputScrapers(&telemetryv1beta1.MetricPipelineHostInputScrapers{
+						Disk:    &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Network: &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+					}).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					Build(),
+			},
+		},
 		{
 			name:           "pipeline using OAuth2 authentication",
 			goldenFileName: "oauth2-authentication.yaml",
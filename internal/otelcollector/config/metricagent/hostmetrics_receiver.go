@@ -0,0 +1,87 @@
+package metricagent
+
+import (
+	"time"
+
+	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
+)
+
+// physicalNetworkInterfacesPattern matches the names of the physical network interfaces of a Node.
+// The virtual interfaces created for Pods, like veth or cali interfaces, are not matched.
+const physicalNetworkInterfacesPattern = "^(eth|en).*"
+
+// excludedMountPoints are mount points of virtual and container filesystems, which are not relevant for the disk space of a Node
+var excludedMountPoints = []string{
+	"^/(dev|proc|sys|run)($|/)",
+	"^/var/lib/(kubelet|containerd|docker)/",
+	"^/snap/",
+}
+
+// excludedFSTypes are types of virtual filesystems, which do not occupy any disk space
+var excludedFSTypes = []string{
+	"autofs", "binfmt_misc", "bpf", "cgroup", "cgroup2", "configfs", "debugfs", "devpts", "devtmpfs", "fusectl", "hugetlbfs",
+	"iso9660", "mqueue", "nsfs", "overlay", "proc", "procfs", "pstore", "rpc_pipefs", "securityfs", "selinuxfs", "squashfs", "sysfs", "tracefs",
+}
+
+func hostMetricsReceiver(hostScrapers hostScraperSources, collectionInterval time.Duration) *HostMetricsReceiverConfig {
+	return &HostMetricsReceiverConfig{
+		CollectionInterval: collectionInterval.String(),
+		// The root filesystem of the Node is mounted to the Metric Agent, so that the metrics of the Node are collected instead of the metrics of the container.
+		RootPath: otelcollector.HostFSVolumePath,
+		Scrapers: hostMetricsScrapers(hostScrapers),
+	}
+}
+
+func hostMetricsScrapers(hostScrapers hostScraperSources) HostMetricsScrapers {
+	var scrapers HostMetricsScrapers
+
+	if hostScrapers.cpu {
+		scrapers.CPU = &HostMetricsScraperConfig{}
+	}
+
+	if hostScrapers.memory {
+		scrapers.Memory = &HostMetricsScraperConfig{}
+	}
+
+	if hostScrapers.disk {
+		scrapers.Disk = &HostMetricsDiskScraperConfig{
+			Metrics: HostMetricsDiskDefaultMetricsToDrop{
+				SystemDiskMerged:            &Metric{Enabled: false},
+				SystemDiskPendingOperations: &Metric{Enabled: false},
+				SystemDiskWeightedIOTime:    &Metric{Enabled: false},
+			},
+		}
+	}
+
+	if hostScrapers.filesystem {
+		scrapers.Filesystem = &HostMetricsFilesystemScraperConfig{
+			ExcludeMountPoints: HostMetricsMountPointsFilter{
+				MountPoints: excludedMountPoints,
+				MatchType:   "regexp",
+			},
+			ExcludeFSTypes: HostMetricsFSTypesFilter{
+				FSTypes:   excludedFSTypes,
+				MatchType: "strict",
+			},
+		}
+	}
+
+	if hostScrapers.network {
+		scrapers.Network = &HostMetricsNetworkScraperConfig{
+			Include: HostMetricsInterfacesFilter{
+				Interfaces: []string{physicalNetworkInterfacesPattern},
+				MatchType:  "regexp",
+			},
+			// The connections metric counts the TCP connections per state and is expensive to collect on Nodes with many Pods.
+			Metrics: HostMetricsNetworkDefaultMetricsToDrop{
+				SystemNetworkConnections: &Metric{Enabled: false},
+			},
+		}
+	}
+
+	if hostScrapers.load {
+		scrapers.Load = &HostMetricsScraperConfig{}
+	}
+
+	return scrapers
+}
@@ -0,0 +1,170 @@
+package metricagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	telemetryutils "github.com/kyma-project/telemetry-manager/internal/utils/telemetry"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestHostMetricsReceiverConfig(t *testing.T) {
+	ctx := context.Background()
+	fakeClient := fake.NewClientBuilder().Build()
+	sut := Builder{
+		Reader: fakeClient,
+	}
+
+	diskScraper := &HostMetricsDiskScraperConfig{
+		Metrics: HostMetricsDiskDefaultMetricsToDrop{
+			SystemDiskMerged:            &Metric{Enabled: false},
+			SystemDiskPendingOperations: &Metric{Enabled: false},
+			SystemDiskWeightedIOTime:    &Metric{Enabled: false},
+		},
+	}
+	filesystemScraper := &HostMetricsFilesystemScraperConfig{
+		ExcludeMountPoints: HostMetricsMountPointsFilter{
+			MountPoints: excludedMountPoints,
+			MatchType:   "regexp",
+		},
+		ExcludeFSTypes: HostMetricsFSTypesFilter{
+			FSTypes:   excludedFSTypes,
+			MatchType: "strict",
+		},
+	}
+	networkScraper := &HostMetricsNetworkScraperConfig{
+		Include: HostMetricsInterfacesFilter{
+			Interfaces: []string{physicalNetworkInterfacesPattern},
+			MatchType:  "regexp",
+		},
+		Metrics: HostMetricsNetworkDefaultMetricsToDrop{
+			SystemNetworkConnections: &Metric{Enabled: false},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		pipelines        []telemetryv1beta1.MetricPipeline
+		expectedScrapers *HostMetricsScrapers
+	}{
+		{
+			name: "host input disabled",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).Build(),
+			},
+		},
+		{
+			name: "all scrapers enabled by default",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithHostInput(true).Build(),
+			},
+			expectedScrapers: &HostMetricsScrapers{
+				CPU:        &HostMetricsScraperConfig{},
+				Memory:     &HostMetricsScraperConfig{},
+				Disk:       diskScraper,
+				Filesystem: filesystemScraper,
+				Network:    networkScraper,
+				Load:       &HostMetricsScraperConfig{},
+			},
+		},
+		{
+			name: "some scrapers disabled",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithHostInput(true).
+					WithHostInputScrapers(&telemetryv1beta1.MetricPipelineHostInputScrapers{
+						Disk:    &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Network: &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Load:    &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+					}).
+					Build(),
+			},
+			expectedScrapers: &HostMetricsScrapers{
+				CPU:        &HostMetricsScraperConfig{},
+				Memory:     &HostMetricsScraperConfig{},
+				Filesystem: filesystemScraper,
+			},
+		},
+		{
+			name: "all scrapers disabled",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithHostInput(true).
+					WithHostInputScrapers(&telemetryv1beta1.MetricPipelineHostInputScrapers{
+						CPU:        &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Memory:     &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Disk:       &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Filesystem: &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Network:    &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Load:       &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+					}).
+					Build(),
+			},
+		},
+		{
+			name: "scrapers enabled in any pipeline",
+			pipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("cpu").
+					WithHostInput(true).
+					WithHostInputScrapers(&telemetryv1beta1.MetricPipelineHostInputScrapers{
+						Memory:     &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Disk:       &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Filesystem: &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Network:    &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Load:       &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+					}).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("memory").
+					WithHostInput(true).
+					WithHostInputScrapers(&telemetryv1beta1.MetricPipelineHostInputScrapers{
+						CPU:        &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Disk:       &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Filesystem: &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Network:    &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+						Load:       &telemetryv1beta1.MetricPipelineHostInputScraper{Enabled: ptr.To(false)},
+					}).
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("disabled").
+					WithHostInput(false).
+					Build(),
+			},
+			expectedScrapers: &HostMetricsScrapers{
+				CPU:    &HostMetricsScraperConfig{},
+				Memory: &HostMetricsScraperConfig{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collectorConfig, _, err := sut.Build(ctx, tt.pipelines, BuildOptions{
+				CollectionIntervals: telemetryutils.ResolveMetricCollectionIntervals(nil),
+			})
+			require.NoError(t, err)
+
+			if tt.expectedScrapers == nil {
+				require.NotContains(t, collectorConfig.Receivers, "hostmetrics")
+				require.NotContains(t, collectorConfig.Service.Pipelines, "metrics/input-host")
+
+				return
+			}
+
+			expectedHostMetricsReceiverConfig := HostMetricsReceiverConfig{
+				CollectionInterval: "30s",
+				RootPath:           "/hostfs",
+				Scrapers:           *tt.expectedScrapers,
+			}
+
+			require.Contains(t, collectorConfig.Receivers, "hostmetrics")
+			require.Equal(t, expectedHostMetricsReceiverConfig, *collectorConfig.Receivers["hostmetrics"].(*HostMetricsReceiverConfig))
+		})
+	}
+}
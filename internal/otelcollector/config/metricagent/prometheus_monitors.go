@@ -0,0 +1,482 @@
+package metricagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+)
+
+// monitorAttribute marks metrics scraped from ServiceMonitor or PodMonitor targets, so that pipelines
+// without the monitors input can drop them. As a kyma.* attribute, it is removed before export.
+const monitorAttribute = "kyma.prometheus.monitor"
+
+var (
+	ServiceMonitorGVK = schema.FromAPIVersionAndKind(names.MonitoringGroupVersion, names.ServiceMonitorKind)
+	PodMonitorGVK     = schema.FromAPIVersionAndKind(names.MonitoringGroupVersion, names.PodMonitorKind)
+
+	errMonitorAuthNotSupported = errors.New("authentication settings are not supported")
+	errMonitorTLSNotSupported  = errors.New("TLS settings referencing files, Secrets, or ConfigMaps are not supported")
+
+	invalidLabelNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+)
+
+type monitorKind string
+
+const (
+	serviceMonitorKind monitorKind = "serviceMonitor"
+	podMonitorKind     monitorKind = "podMonitor"
+)
+
+// monitorSpec holds the subset of the ServiceMonitor and PodMonitor specs (monitoring.coreos.com/v1) that is translated into scrape configs.
+type monitorSpec struct {
+	Selector            metav1.LabelSelector     `json:"selector"`
+	NamespaceSelector   monitorNamespaceSelector `json:"namespaceSelector,omitempty"`
+	TargetLabels        []string                 `json:"targetLabels,omitempty"`
+	PodTargetLabels     []string                 `json:"podTargetLabels,omitempty"`
+	Endpoints           []monitorEndpoint        `json:"endpoints,omitempty"`
+	PodMetricsEndpoints []monitorEndpoint        `json:"podMetricsEndpoints,omitempty"`
+}
+
+type monitorNamespaceSelector struct {
+	Any        bool     `json:"any,omitempty"`
+	MatchNames []string `json:"matchNames,omitempty"`
+}
+
+type monitorEndpoint struct {
+	Port              string                 `json:"port,omitempty"`
+	PortNumber        *int32                 `json:"portNumber,omitempty"`
+	TargetPort        *intstr.IntOrString    `json:"targetPort,omitempty"`
+	Path              string                 `json:"path,omitempty"`
+	Scheme            string                 `json:"scheme,omitempty"`
+	Params            map[string][]string    `json:"params,omitempty"`
+	Interval          string                 `json:"interval,omitempty"`
+	ScrapeTimeout     string                 `json:"scrapeTimeout,omitempty"`
+	HonorLabels       bool                   `json:"honorLabels,omitempty"`
+	TLSConfig         *monitorTLSConfig      `json:"tlsConfig,omitempty"`
+	Relabelings       []monitorRelabelConfig `json:"relabelings,omitempty"`
+	MetricRelabelings []monitorRelabelConfig `json:"metricRelabelings,omitempty"`
+
+	// Credentials are not available to the agent, so endpoints that configure them are skipped.
+	BearerTokenFile   string         `json:"bearerTokenFile,omitempty"`
+	BearerTokenSecret map[string]any `json:"bearerTokenSecret,omitempty"`
+	BasicAuth         map[string]any `json:"basicAuth,omitempty"`
+	Authorization     map[string]any `json:"authorization,omitempty"`
+	OAuth2            map[string]any `json:"oauth2,omitempty"`
+}
+
+type monitorTLSConfig struct {
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+	ServerName         string `json:"serverName,omitempty"`
+
+	CAFile    string         `json:"caFile,omitempty"`
+	CertFile  string         `json:"certFile,omitempty"`
+	KeyFile   string         `json:"keyFile,omitempty"`
+	CA        map[string]any `json:"ca,omitempty"`
+	Cert      map[string]any `json:"cert,omitempty"`
+	KeySecret map[string]any `json:"keySecret,omitempty"`
+}
+
+type monitorRelabelConfig struct {
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+	Separator    string   `json:"separator,omitempty"`
+	TargetLabel  string   `json:"targetLabel,omitempty"`
+	Regex        string   `json:"regex,omitempty"`
+	Modulus      uint64   `json:"modulus,omitempty"`
+	Replacement  string   `json:"replacement,omitempty"`
+	Action       string   `json:"action,omitempty"`
+}
+
+// listMonitors returns all resources of the given monitor kind sorted by namespace and name.
+// If the Prometheus Operator CRDs are not installed in the cluster, no resources are returned.
+func (b *Builder) listMonitors(ctx context.Context, gvk schema.GroupVersionKind) ([]unstructured.Unstructured, error) {
+	var list unstructured.UnstructuredList
+
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	if err := b.Reader.List(ctx, &list); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to list %s resources: %w", gvk.Kind, err)
+	}
+
+	slices.SortFunc(list.Items, func(a, b unstructured.Unstructured) int {
+		return strings.Compare(a.GetNamespace()+"/"+a.GetName(), b.GetNamespace()+"/"+b.GetName())
+	})
+
+	return list.Items, nil
+}
+
+// prometheusMonitorsReceiverConfig creates a Prometheus configuration with one scrape job per ServiceMonitor and PodMonitor endpoint,
+// following the job naming and target labels of the Prometheus Operator. Like the annotation-based jobs, only targets running on the
+// same Node as the agent are scraped. Endpoints that cannot be translated (for example, because they rely on credentials that are not
+// available to the agent) are skipped and logged. Returns nil if there is no scrape job.
+func prometheusMonitorsReceiverConfig(ctx context.Context, serviceMonitors, podMonitors []unstructured.Unstructured, opts BuildOptions, collectionInterval time.Duration) *PrometheusReceiverConfig {
+	var config PrometheusReceiverConfig
+
+	for _, monitor := range serviceMonitors {
+		config.Prometheus.ScrapeConfigs = append(config.Prometheus.ScrapeConfigs, monitorScrapeConfigs(ctx, serviceMonitorKind, &monitor, opts, collectionInterval)...)
+	}
+
+	for _, monitor := range podMonitors {
+		config.Prometheus.ScrapeConfigs = append(config.Prometheus.ScrapeConfigs, monitorScrapeConfigs(ctx, podMonitorKind, &monitor, opts, collectionInterval)...)
+	}
+
+	if len(config.Prometheus.ScrapeConfigs) == 0 {
+		return nil
+	}
+
+	return &config
+}
+
+func monitorScrapeConfigs(ctx context.Context, kind monitorKind, monitor *unstructured.Unstructured, opts BuildOptions, collectionInterval time.Duration) []Scrape {
+	logger := logf.FromContext(ctx).WithValues("kind", kind, "namespace", monitor.GetNamespace(), "name", monitor.GetName())
+
+	spec, err := parseMonitorSpec(monitor)
+	if err != nil {
+		logger.Info("Skipping monitor", "reason", err.Error())
+		return nil
+	}
+
+	endpoints := spec.Endpoints
+	if kind == podMonitorKind {
+		endpoints = spec.PodMetricsEndpoints
+	}
+
+	var scrapeConfigs []Scrape
+
+	for i, endpoint := range endpoints {
+		scrapeConfig, err := monitorScrapeConfig(kind, monitor, spec, i, endpoint, opts, collectionInterval)
+		if err != nil {
+			logger.Info("Skipping monitor endpoint", "endpoint", i, "reason", err.Error())
+			continue
+		}
+
+		scrapeConfigs = append(scrapeConfigs, scrapeConfig)
+	}
+
+	return scrapeConfigs
+}
+
+func parseMonitorSpec(monitor *unstructured.Unstructured) (monitorSpec, error) {
+	var spec monitorSpec
+
+	rawSpec, _, err := unstructured.NestedMap(monitor.Object, "spec")
+	if err != nil {
+		return spec, fmt.Errorf("invalid spec: %w", err)
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSpec, &spec); err != nil {
+		return spec, fmt.Errorf("invalid spec: %w", err)
+	}
+
+	return spec, nil
+}
+
+func monitorScrapeConfig(kind monitorKind, monitor *unstructured.Unstructured, spec monitorSpec, index int, endpoint monitorEndpoint, opts BuildOptions, collectionInterval time.Duration) (Scrape, error) {
+	if endpoint.BearerTokenFile != "" || endpoint.BearerTokenSecret != nil || endpoint.BasicAuth != nil || endpoint.Authorization != nil || endpoint.OAuth2 != nil {
+		return Scrape{}, errMonitorAuthNotSupported
+	}
+
+	interval, timeout, err := monitorScrapeDurations(endpoint, collectionInterval)
+	if err != nil {
+		return Scrape{}, err
+	}
+
+	tls, err := monitorTLS(endpoint, opts)
+	if err != nil {
+		return Scrape{}, err
+	}
+
+	role := RoleEndpoints
+	if kind == podMonitorKind {
+		role = RolePod
+	}
+
+	metricRelabelConfigs := make([]Relabel, 0, len(endpoint.MetricRelabelings))
+	for _, relabeling := range endpoint.MetricRelabelings {
+		metricRelabelConfigs = append(metricRelabelConfigs, convertMonitorRelabelConfig(relabeling))
+	}
+
+	return Scrape{
+		JobName:                    fmt.Sprintf("%s/%s/%s/%d", kind, monitor.GetNamespace(), monitor.GetName(), index),
+		SampleLimit:                sampleLimit,
+		BodySizeLimit:              bodySizeLimit,
+		ScrapeInterval:             interval,
+		ScrapeTimeout:              timeout,
+		MetricsPath:                endpoint.Path,
+		Scheme:                     endpoint.Scheme,
+		Params:                     endpoint.Params,
+		HonorLabels:                endpoint.HonorLabels,
+		KubernetesDiscoveryConfigs: monitorDiscoveryConfig(role, monitorNamespaces(monitor.GetNamespace(), spec.NamespaceSelector)),
+		RelabelConfigs:             monitorRelabelConfigs(kind, spec, endpoint),
+		MetricRelabelConfigs:       metricRelabelConfigs,
+		TLS:                        tls,
+	}, nil
+}
+
+// monitorScrapeDurations resolves the scrape interval and timeout of a monitor endpoint.
+// The collection interval of the Prometheus input is used if the endpoint does not define an interval.
+func monitorScrapeDurations(endpoint monitorEndpoint, collectionInterval time.Duration) (interval, timeout time.Duration, err error) {
+	interval = collectionInterval
+
+	if endpoint.Interval != "" {
+		if interval, err = time.ParseDuration(endpoint.Interval); err != nil {
+			return 0, 0, fmt.Errorf("invalid interval: %w", err)
+		}
+	}
+
+	if endpoint.ScrapeTimeout != "" {
+		if timeout, err = time.ParseDuration(endpoint.ScrapeTimeout); err != nil {
+			return 0, 0, fmt.Errorf("invalid scrape timeout: %w", err)
+		}
+
+		if timeout > interval {
+			return 0, 0, fmt.Errorf("scrape timeout %s is greater than interval %s", timeout, interval)
+		}
+	}
+
+	return interval, timeout, nil
+}
+
+// monitorTLS translates the TLS settings of a monitor endpoint. Certificates cannot be resolved by the agent, so only
+// insecureSkipVerify and serverName are supported. If Istio is active and an HTTPS endpoint has no TLS settings,
+// the Istio certificate of the agent is used, like for annotated Services.
+func monitorTLS(endpoint monitorEndpoint, opts BuildOptions) (*TLS, error) {
+	endpointTLS := endpoint.TLSConfig
+
+	if endpointTLS != nil && (endpointTLS.CAFile != "" || endpointTLS.CertFile != "" || endpointTLS.KeyFile != "" ||
+		endpointTLS.CA != nil || endpointTLS.Cert != nil || endpointTLS.KeySecret != nil) {
+		return nil, errMonitorTLSNotSupported
+	}
+
+	if endpoint.Scheme != "https" {
+		return nil, nil
+	}
+
+	if endpointTLS == nil {
+		if opts.IstioActive {
+			return tlsConfig(opts.IstioCertPath), nil
+		}
+
+		return nil, nil
+	}
+
+	return &TLS{
+		InsecureSkipVerify: endpointTLS.InsecureSkipVerify,
+		ServerName:         endpointTLS.ServerName,
+	}, nil
+}
+
+// monitorNamespaces returns the namespaces in which targets are discovered. An empty list means all namespaces.
+func monitorNamespaces(monitorNamespace string, selector monitorNamespaceSelector) []string {
+	if selector.Any {
+		return nil
+	}
+
+	if len(selector.MatchNames) > 0 {
+		return slices.Sorted(slices.Values(selector.MatchNames))
+	}
+
+	return []string{monitorNamespace}
+}
+
+func monitorDiscoveryConfig(role Role, namespaces []string) []KubernetesDiscovery {
+	discoveryConfigs := discoveryConfigWithNodeSelector(role)
+	if len(namespaces) > 0 {
+		discoveryConfigs[0].Namespaces = &KubernetesDiscoveryNamespaces{Names: namespaces}
+	}
+
+	return discoveryConfigs
+}
+
+// monitorRelabelConfigs generates the relabel configs that select the targets of a monitor endpoint and set the
+// target labels (namespace, service, pod, container, endpoint) in the same way as the Prometheus Operator.
+// The user-defined relabelings of the endpoint are applied last.
+func monitorRelabelConfigs(kind monitorKind, spec monitorSpec, endpoint monitorEndpoint) []Relabel {
+	var relabelConfigs []Relabel
+
+	if kind == serviceMonitorKind {
+		relabelConfigs = append(relabelConfigs, keepIfRunningOnSameNode(NodeAffiliatedEndpoint))
+		relabelConfigs = append(relabelConfigs, selectorRelabelConfigs("__meta_kubernetes_service", spec.Selector)...)
+	} else {
+		relabelConfigs = append(relabelConfigs, keepIfRunningOnSameNode(NodeAffiliatedPod))
+		relabelConfigs = append(relabelConfigs, selectorRelabelConfigs("__meta_kubernetes_pod", spec.Selector)...)
+	}
+
+	relabelConfigs = append(relabelConfigs, portRelabelConfigs(kind, endpoint)...)
+	relabelConfigs = append(relabelConfigs,
+		dropIfPodNotRunning(),
+		dropIfInitContainer(),
+		copyLabel("__meta_kubernetes_namespace", "namespace"),
+	)
+
+	if kind == serviceMonitorKind {
+		relabelConfigs = append(relabelConfigs, copyLabel("__meta_kubernetes_service_name", "service"))
+	}
+
+	relabelConfigs = append(relabelConfigs,
+		copyLabel("__meta_kubernetes_pod_name", "pod"),
+		copyLabel("__meta_kubernetes_pod_container_name", "container"),
+	)
+
+	if endpoint.Port != "" {
+		relabelConfigs = append(relabelConfigs, Relabel{
+			TargetLabel: "endpoint",
+			Replacement: escapeDollarSigns(endpoint.Port),
+			Action:      Replace,
+		})
+	}
+
+	for _, label := range spec.TargetLabels {
+		relabelConfigs = append(relabelConfigs, copyLabel("__meta_kubernetes_service_label_"+sanitizeLabelName(label), sanitizeLabelName(label)))
+	}
+
+	for _, label := range spec.PodTargetLabels {
+		relabelConfigs = append(relabelConfigs, copyLabel("__meta_kubernetes_pod_label_"+sanitizeLabelName(label), sanitizeLabelName(label)))
+	}
+
+	for _, relabeling := range endpoint.Relabelings {
+		relabelConfigs = append(relabelConfigs, convertMonitorRelabelConfig(relabeling))
+	}
+
+	return relabelConfigs
+}
+
+// selectorRelabelConfigs translates a label selector into keep and drop relabel configs
+// based on the label and labelpresent meta labels of the discovered object.
+func selectorRelabelConfigs(metaPrefix string, selector metav1.LabelSelector) []Relabel {
+	var relabelConfigs []Relabel
+
+	labelMeta := func(key string) string { return metaPrefix + "_label_" + sanitizeLabelName(key) }
+	presentMeta := func(key string) string { return metaPrefix + "_labelpresent_" + sanitizeLabelName(key) }
+
+	for _, key := range slices.Sorted(maps.Keys(selector.MatchLabels)) {
+		relabelConfigs = append(relabelConfigs, Relabel{
+			SourceLabels: []string{labelMeta(key), presentMeta(key)},
+			Regex:        fmt.Sprintf("(%s);true", quoteRegex(selector.MatchLabels[key])),
+			Action:       Keep,
+		})
+	}
+
+	for _, expr := range selector.MatchExpressions {
+		switch expr.Operator {
+		case metav1.LabelSelectorOpIn, metav1.LabelSelectorOpNotIn:
+			action := Keep
+			if expr.Operator == metav1.LabelSelectorOpNotIn {
+				action = Drop
+			}
+
+			values := make([]string, 0, len(expr.Values))
+			for _, value := range expr.Values {
+				values = append(values, quoteRegex(value))
+			}
+
+			relabelConfigs = append(relabelConfigs, Relabel{
+				SourceLabels: []string{labelMeta(expr.Key), presentMeta(expr.Key)},
+				Regex:        fmt.Sprintf("(%s);true", strings.Join(values, "|")),
+				Action:       action,
+			})
+		case metav1.LabelSelectorOpExists, metav1.LabelSelectorOpDoesNotExist:
+			action := Keep
+			if expr.Operator == metav1.LabelSelectorOpDoesNotExist {
+				action = Drop
+			}
+
+			relabelConfigs = append(relabelConfigs, Relabel{
+				SourceLabels: []string{presentMeta(expr.Key)},
+				Regex:        "true",
+				Action:       action,
+			})
+		}
+	}
+
+	return relabelConfigs
+}
+
+// portRelabelConfigs keeps only the targets that match the port of a monitor endpoint.
+func portRelabelConfigs(kind monitorKind, endpoint monitorEndpoint) []Relabel {
+	portNameLabel := "__meta_kubernetes_pod_container_port_name"
+	if kind == serviceMonitorKind {
+		portNameLabel = "__meta_kubernetes_endpoint_port_name"
+	}
+
+	switch {
+	case endpoint.Port != "":
+		return []Relabel{{
+			SourceLabels: []string{portNameLabel},
+			Regex:        quoteRegex(endpoint.Port),
+			Action:       Keep,
+		}}
+	case kind == podMonitorKind && endpoint.PortNumber != nil:
+		return []Relabel{{
+			SourceLabels: []string{"__meta_kubernetes_pod_container_port_number"},
+			Regex:        fmt.Sprintf("%d", *endpoint.PortNumber),
+			Action:       Keep,
+		}}
+	case endpoint.TargetPort != nil && endpoint.TargetPort.Type == intstr.String:
+		return []Relabel{{
+			SourceLabels: []string{"__meta_kubernetes_pod_container_port_name"},
+			Regex:        quoteRegex(endpoint.TargetPort.StrVal),
+			Action:       Keep,
+		}}
+	case endpoint.TargetPort != nil:
+		return []Relabel{{
+			SourceLabels: []string{"__meta_kubernetes_pod_container_port_number"},
+			Regex:        fmt.Sprintf("%d", endpoint.TargetPort.IntVal),
+			Action:       Keep,
+		}}
+	}
+
+	return nil
+}
+
+func convertMonitorRelabelConfig(relabeling monitorRelabelConfig) Relabel {
+	return Relabel{
+		SourceLabels: relabeling.SourceLabels,
+		Separator:    escapeDollarSigns(relabeling.Separator),
+		Regex:        escapeDollarSigns(relabeling.Regex),
+		Modulus:      relabeling.Modulus,
+		TargetLabel:  relabeling.TargetLabel,
+		Replacement:  escapeDollarSigns(relabeling.Replacement),
+		Action:       RelabelAction(strings.ToLower(relabeling.Action)),
+	}
+}
+
+func copyLabel(sourceLabel, targetLabel string) Relabel {
+	return Relabel{
+		SourceLabels: []string{sourceLabel},
+		TargetLabel:  targetLabel,
+		Action:       Replace,
+	}
+}
+
+// sanitizeLabelName converts a Kubernetes label name into the form used in Prometheus meta labels.
+func sanitizeLabelName(name string) string {
+	return invalidLabelNameChars.ReplaceAllString(name, "_")
+}
+
+func quoteRegex(value string) string {
+	return escapeDollarSigns(regexp.QuoteMeta(value))
+}
+
+// escapeDollarSigns escapes user-provided values, which would otherwise be subject to environment variable expansion in the collector configuration.
+func escapeDollarSigns(value string) string {
+	return strings.ReplaceAll(value, "$", "$$")
+}
@@ -0,0 +1,317 @@
+package metricagent
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	k8syaml "sigs.k8s.io/yaml"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	telemetryutils "github.com/kyma-project/telemetry-manager/internal/utils/telemetry"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+const serviceMonitorYAML = `
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: backend
+  namespace: shop
+spec:
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: backend
+    matchExpressions:
+      - key: tier
+        operator: In
+        values: [api, web]
+      - key: canary
+        operator: DoesNotExist
+  targetLabels:
+    - team
+  endpoints:
+    - port: http-metrics
+      path: /custom/metrics
+      interval: 15s
+      scrapeTimeout: 10s
+      relabelings:
+        - sourceLabels: [__meta_kubernetes_pod_label_version]
+          regex: (v\d+)
+          targetLabel: version
+          replacement: ${1}
+          action: Replace
+      metricRelabelings:
+        - sourceLabels: [__name__]
+          regex: go_.*
+          action: drop
+    - port: https-metrics
+      scheme: https
+      tlsConfig:
+        insecureSkipVerify: true
+        serverName: backend.shop.svc
+    - port: secured
+      basicAuth:
+        username:
+          name: credentials
+          key: username
+`
+
+const podMonitorYAML = `
+apiVersion: monitoring.coreos.com/v1
+kind: PodMonitor
+metadata:
+  name: worker
+  namespace: batch
+spec:
+  namespaceSelector:
+    any: true
+  selector:
+    matchLabels:
+      app: worker
+  podMetricsEndpoints:
+    - portNumber: 9090
+      params:
+        format: [prometheus]
+`
+
+func TestBuildConfigWithPrometheusMonitors(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithObjects(
+		unstructuredFromYAML(t, serviceMonitorYAML),
+		unstructuredFromYAML(t, podMonitorYAML),
+	).Build()
+	sut := Builder{
+		Reader: fakeClient,
+	}
+
+	pipelines := []telemetryv1beta1.MetricPipeline{
+		testutils.NewMetricPipelineBuilder().
+			WithName("with-monitors").
+			WithPrometheusInput(true).
+			WithPrometheusInputMonitors(true).
+			Build(),
+		testutils.NewMetricPipelineBuilder().
+			WithName("without-monitors").
+			WithPrometheusInput(true).
+			Build(),
+	}
+
+	config, _, err := sut.Build(t.Context(), pipelines, BuildOptions{
+		IstioCertPath:               "/etc/istio-output-certs",
+		InstrumentationScopeVersion: "main",
+		CollectionIntervals:         telemetryutils.ResolveMetricCollectionIntervals(nil),
+	})
+	require.NoError(t, err)
+
+	configYAML, err := yaml.Marshal(config)
+	require.NoError(t, err, "failed to marshal config")
+
+	goldenFilePath := filepath.Join("testdata", "prometheus-monitors.yaml")
+	if testutils.ShouldUpdateGoldenFiles() {
+		testutils.UpdateGoldenFileYAML(t, goldenFilePath, configYAML)
+		return
+	}
+
+	goldenFile, err := os.ReadFile(goldenFilePath)
+	require.NoError(t, err, "failed to load golden file")
+	require.Equal(t, string(goldenFile), string(configYAML))
+}
+
+func TestPrometheusMonitorsReceiverConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		objects           []client.Object
+		monitorsEnabled   bool
+		expectedJobs      []string
+		expectDropMonitor bool
+	}{
+		{
+			name:            "monitors disabled",
+			objects:         []client.Object{unstructuredFromYAML(t, serviceMonitorYAML)},
+			monitorsEnabled: false,
+		},
+		{
+			name:            "monitors enabled without monitor resources",
+			monitorsEnabled: true,
+		},
+		{
+			name:            "monitors enabled",
+			objects:         []client.Object{unstructuredFromYAML(t, serviceMonitorYAML), unstructuredFromYAML(t, podMonitorYAML)},
+			monitorsEnabled: true,
+			expectedJobs: []string{
+				"serviceMonitor/shop/backend/0",
+				"serviceMonitor/shop/backend/1",
+				"podMonitor/batch/worker/0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sut := Builder{
+				Reader: fake.NewClientBuilder().WithObjects(tt.objects...).Build(),
+			}
+
+			collectorConfig, _, err := sut.Build(t.Context(), []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().WithPrometheusInput(true).WithPrometheusInputMonitors(tt.monitorsEnabled).Build(),
+			}, BuildOptions{
+				CollectionIntervals: telemetryutils.ResolveMetricCollectionIntervals(nil),
+			})
+			require.NoError(t, err)
+
+			if len(tt.expectedJobs) == 0 {
+				require.NotContains(t, collectorConfig.Receivers, common.ComponentIDPrometheusAppMonitorsReceiver)
+				return
+			}
+
+			require.Contains(t, collectorConfig.Receivers, common.ComponentIDPrometheusAppMonitorsReceiver)
+			receiverConfig := collectorConfig.Receivers[common.ComponentIDPrometheusAppMonitorsReceiver].(*PrometheusReceiverConfig)
+
+			var jobs []string
+			for _, scrapeConfig := range receiverConfig.Prometheus.ScrapeConfigs {
+				jobs = append(jobs, scrapeConfig.JobName)
+			}
+
+			require.Equal(t, tt.expectedJobs, jobs)
+		})
+	}
+}
+
+func TestSelectorRelabelConfigs(t *testing.T) {
+	selector := metav1.LabelSelector{
+		MatchLabels: map[string]string{"app.kubernetes.io/name": "backend", "env": "prod$"},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"db"}},
+			{Key: "team", Operator: metav1.LabelSelectorOpExists},
+		},
+	}
+
+	require.Equal(t, []Relabel{
+		{
+			SourceLabels: []string{"__meta_kubernetes_pod_label_app_kubernetes_io_name", "__meta_kubernetes_pod_labelpresent_app_kubernetes_io_name"},
+			Regex:        "(backend);true",
+			Action:       Keep,
+		},
+		{
+			SourceLabels: []string{"__meta_kubernetes_pod_label_env", "__meta_kubernetes_pod_labelpresent_env"},
+			Regex:        "(prod\\$$);true",
+			Action:       Keep,
+		},
+		{
+			SourceLabels: []string{"__meta_kubernetes_pod_label_tier", "__meta_kubernetes_pod_labelpresent_tier"},
+			Regex:        "(db);true",
+			Action:       Drop,
+		},
+		{
+			SourceLabels: []string{"__meta_kubernetes_pod_labelpresent_team"},
+			Regex:        "true",
+			Action:       Keep,
+		},
+	}, selectorRelabelConfigs("__meta_kubernetes_pod", selector))
+}
+
+func TestMonitorScrapeDurations(t *testing.T) {
+	tests := []struct {
+		name             string
+		endpoint         monitorEndpoint
+		expectedInterval time.Duration
+		expectedTimeout  time.Duration
+		expectError      bool
+	}{
+		{
+			name:             "defaults to collection interval",
+			expectedInterval: 30 * time.Second,
+		},
+		{
+			name:             "interval and timeout",
+			endpoint:         monitorEndpoint{Interval: "1m", ScrapeTimeout: "20s"},
+			expectedInterval: time.Minute,
+			expectedTimeout:  20 * time.Second,
+		},
+		{
+			name:        "invalid interval",
+			endpoint:    monitorEndpoint{Interval: "often"},
+			expectError: true,
+		},
+		{
+			name:        "timeout greater than interval",
+			endpoint:    monitorEndpoint{Interval: "10s", ScrapeTimeout: "15s"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interval, timeout, err := monitorScrapeDurations(tt.endpoint, 30*time.Second)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedInterval, interval)
+			require.Equal(t, tt.expectedTimeout, timeout)
+		})
+	}
+}
+
+func TestMonitorTLS(t *testing.T) {
+	tests := []struct {
+		name        string
+		endpoint    monitorEndpoint
+		istioActive bool
+		expected    *TLS
+		expectedErr error
+	}{
+		{
+			name:     "http endpoint",
+			endpoint: monitorEndpoint{TLSConfig: &monitorTLSConfig{InsecureSkipVerify: true}},
+		},
+		{
+			name:     "https endpoint",
+			endpoint: monitorEndpoint{Scheme: "https", TLSConfig: &monitorTLSConfig{ServerName: "backend"}},
+			expected: &TLS{ServerName: "backend"},
+		},
+		{
+			name:        "https endpoint without TLS config and istio active",
+			endpoint:    monitorEndpoint{Scheme: "https"},
+			istioActive: true,
+			expected:    tlsConfig("/etc/istio-output-certs"),
+		},
+		{
+			name:        "https endpoint with CA from secret",
+			endpoint:    monitorEndpoint{Scheme: "https", TLSConfig: &monitorTLSConfig{CA: map[string]any{"secret": map[string]any{"name": "ca"}}}},
+			expectedErr: errMonitorTLSNotSupported,
+		},
+		{
+			name:        "https endpoint with CA file",
+			endpoint:    monitorEndpoint{Scheme: "https", TLSConfig: &monitorTLSConfig{CAFile: "/etc/ca.pem"}},
+			expectedErr: errMonitorTLSNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tls, err := monitorTLS(tt.endpoint, BuildOptions{IstioActive: tt.istioActive, IstioCertPath: "/etc/istio-output-certs"})
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expected, tls)
+		})
+	}
+}
+
+func unstructuredFromYAML(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+
+	var obj unstructured.Unstructured
+	require.NoError(t, k8syaml.Unmarshal([]byte(manifest), &obj.Object))
+
+	return &obj
+}
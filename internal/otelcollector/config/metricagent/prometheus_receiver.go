@@ -97,6 +97,8 @@ func prometheusPodsRelabelConfigs() []Relabel {
 		inferMetricsPathFromAnnotation(AnnotatedPod),
 		inferAddressFromAnnotation(AnnotatedPod),
 		inferURLParamFromAnnotation(AnnotatedPod),
+		inferScrapeIntervalFromAnnotation(AnnotatedPod),
+		inferScrapeTimeoutFromAnnotation(AnnotatedPod),
 	}
 }
 
@@ -116,6 +118,8 @@ func prometheusEndpointsRelabelConfigs(requireHTTPS bool) []Relabel {
 		inferSchemeFromIstioInjectedLabel(),
 		inferSchemeFromAnnotation(AnnotatedService),
 		inferURLParamFromAnnotation(AnnotatedService),
+		inferScrapeIntervalFromAnnotation(AnnotatedService),
+		inferScrapeTimeoutFromAnnotation(AnnotatedService),
 	}
 
 	if requireHTTPS {
@@ -314,6 +318,30 @@ func inferURLParamFromAnnotation(annotated AnnotatedResource) Relabel {
 	}
 }
 
+// inferScrapeIntervalFromAnnotation overrides the scrape interval of the job
+// for targets annotated with prometheus.io/interval: {duration}.
+// There is no such override for prometheus.io/sample-limit: Prometheus supports the sample limit only per scrape job,
+// so the job-wide sampleLimit applies to all targets and the annotation is deliberately ignored.
+func inferScrapeIntervalFromAnnotation(annotated AnnotatedResource) Relabel {
+	return Relabel{
+		SourceLabels: []string{fmt.Sprintf("__meta_kubernetes_%s_annotation_prometheus_io_interval", annotated)},
+		Action:       Replace,
+		Regex:        "(.+)",
+		TargetLabel:  "__scrape_interval__",
+	}
+}
+
+// inferScrapeTimeoutFromAnnotation overrides the scrape timeout of the job
+// for targets annotated with prometheus.io/timeout: {duration}.
+func inferScrapeTimeoutFromAnnotation(annotated AnnotatedResource) Relabel {
+	return Relabel{
+		SourceLabels: []string{fmt.Sprintf("__meta_kubernetes_%s_annotation_prometheus_io_timeout", annotated)},
+		Action:       Replace,
+		Regex:        "(.+)",
+		TargetLabel:  "__scrape_timeout__",
+	}
+}
+
 func discoveryConfigWithNodeSelector(role Role) []KubernetesDiscovery {
 	return []KubernetesDiscovery{
 		{
@@ -237,6 +237,62 @@ type K8sClusterReceiverConfig struct {
 	K8sLeaderElector       string            `yaml:"k8s_leader_elector"`
 }
 
+type HostMetricsReceiverConfig struct {
+	CollectionInterval string              `yaml:"collection_interval"`
+	RootPath           string              `yaml:"root_path"`
+	Scrapers           HostMetricsScrapers `yaml:"scrapers"`
+}
+
+type HostMetricsScrapers struct {
+	CPU        *HostMetricsScraperConfig           `yaml:"cpu,omitempty"`
+	Memory     *HostMetricsScraperConfig           `yaml:"memory,omitempty"`
+	Disk       *HostMetricsDiskScraperConfig       `yaml:"disk,omitempty"`
+	Filesystem *HostMetricsFilesystemScraperConfig `yaml:"filesystem,omitempty"`
+	Network    *HostMetricsNetworkScraperConfig    `yaml:"network,omitempty"`
+	Load       *HostMetricsScraperConfig           `yaml:"load,omitempty"`
+}
+
+type HostMetricsScraperConfig struct{}
+
+type HostMetricsDiskScraperConfig struct {
+	Metrics HostMetricsDiskDefaultMetricsToDrop `yaml:"metrics"`
+}
+
+type HostMetricsDiskDefaultMetricsToDrop struct {
+	SystemDiskMerged            *Metric `yaml:"system.disk.merged"`
+	SystemDiskPendingOperations *Metric `yaml:"system.disk.pending_operations"`
+	SystemDiskWeightedIOTime    *Metric `yaml:"system.disk.weighted_io_time"`
+}
+
+type HostMetricsFilesystemScraperConfig struct {
+	ExcludeMountPoints HostMetricsMountPointsFilter `yaml:"exclude_mount_points"`
+	ExcludeFSTypes     HostMetricsFSTypesFilter     `yaml:"exclude_fs_types"`
+}
+
+type HostMetricsMountPointsFilter struct {
+	MountPoints []string `yaml:"mount_points"`
+	MatchType   string   `yaml:"match_type"`
+}
+
+type HostMetricsFSTypesFilter struct {
+	FSTypes   []string `yaml:"fs_types"`
+	MatchType string   `yaml:"match_type"`
+}
+
+type HostMetricsNetworkScraperConfig struct {
+	Include HostMetricsInterfacesFilter            `yaml:"include"`
+	Metrics HostMetricsNetworkDefaultMetricsToDrop `yaml:"metrics"`
+}
+
+type HostMetricsInterfacesFilter struct {
+	Interfaces []string `yaml:"interfaces"`
+	MatchType  string   `yaml:"match_type"`
+}
+
+type HostMetricsNetworkDefaultMetricsToDrop struct {
+	SystemNetworkConnections *Metric `yaml:"system.network.connections"`
+}
+
 type PrometheusReceiverConfig struct {
 	Prometheus PrometheusScrape `yaml:"config"`
 }
@@ -246,13 +302,17 @@ type PrometheusScrape struct {
 }
 
 type Scrape struct {
-	JobName              string        `yaml:"job_name"`
-	SampleLimit          int           `yaml:"sample_limit,omitempty"`
-	BodySizeLimit        string        `yaml:"body_size_limit,omitempty"`
-	ScrapeInterval       time.Duration `yaml:"scrape_interval,omitempty"`
-	MetricsPath          string        `yaml:"metrics_path,omitempty"`
-	RelabelConfigs       []Relabel     `yaml:"relabel_configs,omitempty"`
-	MetricRelabelConfigs []Relabel     `yaml:"metric_relabel_configs,omitempty"`
+	JobName              string              `yaml:"job_name"`
+	SampleLimit          int                 `yaml:"sample_limit,omitempty"`
+	BodySizeLimit        string              `yaml:"body_size_limit,omitempty"`
+	ScrapeInterval       time.Duration       `yaml:"scrape_interval,omitempty"`
+	ScrapeTimeout        time.Duration       `yaml:"scrape_timeout,omitempty"`
+	MetricsPath          string              `yaml:"metrics_path,omitempty"`
+	Scheme               string              `yaml:"scheme,omitempty"`
+	Params               map[string][]string `yaml:"params,omitempty"`
+	HonorLabels          bool                `yaml:"honor_labels,omitempty"`
+	RelabelConfigs       []Relabel           `yaml:"relabel_configs,omitempty"`
+	MetricRelabelConfigs []Relabel           `yaml:"metric_relabel_configs,omitempty"`
 
 	KubernetesDiscoveryConfigs []KubernetesDiscovery `yaml:"kubernetes_sd_configs,omitempty"`
 
@@ -263,12 +323,18 @@ type TLS struct {
 	CAFile             string `yaml:"ca_file,omitempty"`
 	CertFile           string `yaml:"cert_file,omitempty"`
 	KeyFile            string `yaml:"key_file,omitempty"`
+	ServerName         string `yaml:"server_name,omitempty"`
 	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
 }
 
 type KubernetesDiscovery struct {
-	Role      Role                   `yaml:"role"`
-	Selectors []K8SDiscoverySelector `yaml:"selectors,omitempty"`
+	Role       Role                           `yaml:"role"`
+	Namespaces *KubernetesDiscoveryNamespaces `yaml:"namespaces,omitempty"`
+	Selectors  []K8SDiscoverySelector         `yaml:"selectors,omitempty"`
+}
+
+type KubernetesDiscoveryNamespaces struct {
+	Names []string `yaml:"names"`
 }
 
 type Role string
@@ -3,6 +3,7 @@ package otlpgateway
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strings"
 
@@ -13,8 +14,13 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
+	"github.com/kyma-project/telemetry-manager/internal/resources/otelcollector"
 )
 
+// sendingQueueStorageDirectory is the directory in which the persistent sending queues of the exporters are stored
+var sendingQueueStorageDirectory = filepath.Join(otelcollector.SendingQueueVolumePath, names.OTLPGateway)
+
 type buildTraceComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.TracePipeline]
 type buildLogComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.LogPipeline]
 type buildMetricComponentFunc = common.BuildComponentFunc[*telemetryv1beta1.MetricPipeline]
@@ -5,28 +5,27 @@ import (
 	"fmt"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/namespaces"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
-	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
+	logpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/logpipeline"
 )
 
 // buildLogPipelines builds log pipeline configuration and adds it to the shared config.
 func (b *Builder) buildLogPipelines(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], opts BuildOptions) error {
-	pipelines := opts.LogPipelines
-	if len(pipelines) == 0 {
+	logPipelines := opts.LogPipelines
+	if len(logPipelines) == 0 {
 		return nil
 	}
 
-	queueSize := common.BatchingMaxQueueSize / len(pipelines)
+	queueSize := common.BatchingMaxQueueSize / countLogOutputs(logPipelines)
 
-	for _, pipeline := range pipelines {
+	for _, pipeline := range logPipelines {
 		pipelineID := formatLogServicePipelineID(&pipeline)
 
-		if shouldEnableLogOAuth2(&pipeline) {
-			if err := b.addLogOAuth2Extension(ctx, builder, &pipeline); err != nil {
-				return err
-			}
+		if err := builder.AddOAuth2Extensions(ctx, b.Reader, pipelines.LogPipelineOutputs(&pipeline)); err != nil {
+			return err
 		}
 
 		if err := builder.AddServicePipeline(ctx, &pipeline, pipelineID,
@@ -39,19 +38,56 @@ func (b *Builder) buildLogPipelines(ctx context.Context, builder *common.Compone
 			b.addLogIstioNoiseFilterProcessor(builder),
 			b.addDropIfInputSourceOTLPProcessor(builder),
 			b.addNamespaceFilterProcessor(builder),
+			b.addLogMinSeverityFilterProcessor(builder),
 			b.addLogInsertClusterAttributesProcessor(builder, opts),
 			b.addLogServiceEnrichmentProcessor(builder, opts),
 			// Kyma attributes are dropped before user-defined transform and filter processors
 			// to prevent user access to internal attributes.
 			b.addLogDropKymaAttributesProcessor(builder),
 			b.addLogIstioAccessLogsEnrichmentProcessor(builder, opts),
+			b.addLogRedactionProcessor(builder),
 			b.addLogUserDefinedTransformProcessor(builder),
 			b.addLogUserDefinedFilterProcessor(builder),
+			b.addLogElasticsearchIndexProcessor(builder),
 			b.addLogBatchProcessor(builder),
-			b.addLogOTLPExporter(builder, queueSize),
+			b.addLogOTLPExporters(builder, queueSize),
+			b.addLogKafkaExporters(builder, queueSize),
+			b.addLogElasticsearchExporters(builder, queueSize),
 		); err != nil {
 			return fmt.Errorf("failed to add log service pipeline: %w", err)
 		}
+
+		if !logpipelineutils.IsEventsInputEnabled(&pipeline.Spec.Input) {
+			continue
+		}
+
+		// Kubernetes events are collected by a single gateway instance, which is elected as leader
+		builder.AddExtension(common.ComponentIDK8sEventsLeaderElectorExtension,
+			common.K8sLeaderElectorExtensionConfig{
+				AuthType:       "serviceAccount",
+				LeaseName:      common.K8sLeaderElectorK8sEvents,
+				LeaseNamespace: opts.GatewayNamespace,
+			},
+			nil,
+		)
+
+		if err := builder.AddServicePipeline(ctx, &pipeline, formatLogEventsServicePipelineID(&pipeline),
+			b.addK8sEventsReceiver(builder),
+			b.addLogMemoryLimiterProcessor(builder),
+			b.addK8sEventsResourceAttributesProcessor(builder),
+			b.addK8sEventsFilterProcessor(builder),
+			b.addLogInsertClusterAttributesProcessor(builder, opts),
+			b.addLogRedactionProcessor(builder),
+			b.addLogUserDefinedTransformProcessor(builder),
+			b.addLogUserDefinedFilterProcessor(builder),
+			b.addLogElasticsearchIndexProcessor(builder),
+			b.addLogBatchProcessor(builder),
+			b.addLogOTLPExporters(builder, queueSize),
+			b.addLogKafkaExporters(builder, queueSize),
+			b.addLogElasticsearchExporters(builder, queueSize),
+		); err != nil {
+			return fmt.Errorf("failed to add log events service pipeline: %w", err)
+		}
 	}
 
 	return nil
@@ -66,6 +102,18 @@ func (b *Builder) addLogOTLPReceiver(builder *common.ComponentBuilder[*telemetry
 	)
 }
 
+func (b *Builder) addK8sEventsReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDK8sEventsReceiver),
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			return &K8sEventsReceiverConfig{
+				AuthType:         "serviceAccount",
+				K8sLeaderElector: common.ComponentIDK8sEventsLeaderElectorExtension,
+			}
+		},
+	)
+}
+
 //nolint:mnd // hardcoded values
 func (b *Builder) addLogMemoryLimiterProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
 	return builder.AddProcessor(
@@ -136,7 +184,7 @@ func (b *Builder) addDropIfInputSourceOTLPProcessor(builder *common.ComponentBui
 	return builder.AddProcessor(
 		builder.StaticComponentID(common.ComponentIDDropIfInputSourceOTLPProcessor),
 		func(lp *telemetryv1beta1.LogPipeline) any {
-			if sharedtypesutils.IsOTLPInputEnabled(lp.Spec.Input.OTLP) {
+			if logpipelineutils.IsOTLPInputEnabled(&lp.Spec.Input) {
 				return nil // Skip this processor if OTLP input is enabled
 			}
 
@@ -150,7 +198,7 @@ func (b *Builder) addNamespaceFilterProcessor(builder *common.ComponentBuilder[*
 		formatNamespaceFilterID,
 		func(lp *telemetryv1beta1.LogPipeline) any {
 			otlpInput := lp.Spec.Input.OTLP
-			if otlpInput == nil || !sharedtypesutils.IsOTLPInputEnabled(otlpInput) || !shouldFilterByNamespace(otlpInput.Namespaces) {
+			if otlpInput == nil || !logpipelineutils.IsOTLPInputEnabled(&lp.Spec.Input) || !shouldFilterByNamespace(otlpInput.Namespaces) {
 				return nil // No namespace filter needed
 			}
 
@@ -159,6 +207,47 @@ func (b *Builder) addNamespaceFilterProcessor(builder *common.ComponentBuilder[*
 	)
 }
 
+func (b *Builder) addLogMinSeverityFilterProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+	return builder.AddProcessor(
+		formatLogMinSeverityFilterProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			otlpInput := lp.Spec.Input.OTLP
+			if otlpInput == nil || !logpipelineutils.IsOTLPInputEnabled(&lp.Spec.Input) || otlpInput.MinSeverity == nil {
+				return nil
+			}
+
+			if config := common.MinSeverityFilterProcessor(otlpInput.MinSeverity); config != nil {
+				return config
+			}
+
+			return nil // All logs are kept, no processor needed
+		},
+	)
+}
+
+func (b *Builder) addK8sEventsResourceAttributesProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+	return builder.AddProcessor(
+		builder.StaticComponentID(common.ComponentIDK8sEventsResourceAttributesProcessor),
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			return common.LogTransformProcessor(k8sEventsResourceAttributesStatements())
+		},
+	)
+}
+
+func (b *Builder) addK8sEventsFilterProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+	return builder.AddProcessor(
+		formatK8sEventsFilterID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			conditions := k8sEventsFilterConditions(lp.Spec.Input.Events)
+			if len(conditions) == 0 {
+				return nil // All events are selected, no filter needed
+			}
+
+			return common.LogFilterProcessor([]telemetryv1beta1.FilterSpec{{Conditions: conditions}})
+		},
+	)
+}
+
 func (b *Builder) addLogInsertClusterAttributesProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], opts BuildOptions) buildLogComponentFunc {
 	return builder.AddProcessor(
 		builder.StaticComponentID(common.ComponentIDInsertClusterAttributesProcessor),
@@ -199,6 +288,19 @@ func (b *Builder) addLogIstioAccessLogsEnrichmentProcessor(builder *common.Compo
 	)
 }
 
+func (b *Builder) addLogRedactionProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+	return builder.AddProcessor(
+		formatLogRedactionProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			if lp.Spec.Redaction == nil {
+				return nil // No redaction, no processor needed
+			}
+
+			return common.RedactionProcessor(lp.Spec.Redaction)
+		},
+	)
+}
+
 func (b *Builder) addLogUserDefinedTransformProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
 	return builder.AddProcessor(
 		formatLogUserDefinedTransformProcessorID,
@@ -227,6 +329,20 @@ func (b *Builder) addLogUserDefinedFilterProcessor(builder *common.ComponentBuil
 	)
 }
 
+func (b *Builder) addLogElasticsearchIndexProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
+	return builder.AddProcessor(
+		formatLogElasticsearchIndexProcessorID,
+		func(lp *telemetryv1beta1.LogPipeline) any {
+			statements := common.ElasticsearchIndexProcessorStatements(lp.Spec.Output.Elasticsearch)
+			if statements == nil {
+				return nil // Static or default index, no processor needed
+			}
+
+			return common.LogTransformProcessor(statements)
+		},
+	)
+}
+
 //nolint:mnd // hardcoded values
 func (b *Builder) addLogBatchProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline]) buildLogComponentFunc {
 	return builder.AddProcessor(
@@ -241,55 +357,58 @@ func (b *Builder) addLogBatchProcessor(builder *common.ComponentBuilder[*telemet
 	)
 }
 
-func (b *Builder) addLogOTLPExporter(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], queueSize int) buildLogComponentFunc {
-	return builder.AddExporter(
-		formatLogOTLPExporterID,
-		func(ctx context.Context, lp *telemetryv1beta1.LogPipeline) (any, common.EnvVars, error) {
-			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
-				b.Reader,
-				lp.Spec.Output.OTLP,
-				pipelines.LogPipelineRef(lp),
-				common.NewSendingQueue(queueSize),
-			)
+func (b *Builder) addLogOTLPExporters(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], queueSize int) buildLogComponentFunc {
+	return builder.AddOTLPExporters(b.Reader, pipelines.LogPipelineOutputs, common.NewSendingQueue(queueSize, common.WithPersistentStorage(sendingQueueStorageDirectory)))
+}
 
-			return otlpExporterBuilder.OTLPExporter(ctx)
-		},
-	)
+func (b *Builder) addLogKafkaExporters(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], queueSize int) buildLogComponentFunc {
+	return builder.AddKafkaExporters(b.Reader, pipelines.LogPipelineKafkaOutputs, common.NewSendingQueue(queueSize))
 }
 
-//nolint:dupl // Acceptable duplication - trace and log OAuth2 extensions follow same pattern
-func (b *Builder) addLogOAuth2Extension(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], pipeline *telemetryv1beta1.LogPipeline) error {
-	pipelineRef := pipelines.LogPipelineRef(pipeline)
-	oauth2ExtensionID := common.ComponentIDOAuth2Extension(pipelineRef)
+func (b *Builder) addLogElasticsearchExporters(builder *common.ComponentBuilder[*telemetryv1beta1.LogPipeline], queueSize int) buildLogComponentFunc {
+	return builder.AddElasticsearchExporters(b.Reader, pipelines.LogPipelineElasticsearchOutputs, common.NewSendingQueue(queueSize))
+}
+
+// Log pipeline helper functions
 
-	oauth2ExtensionConfig, oauth2ExtensionEnvVars, err := common.NewOAuth2ExtensionConfigBuilder(
-		b.Reader,
-		pipeline.Spec.Output.OTLP.Authentication.OAuth2,
-		pipelineRef,
-	).OAuth2Extension(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to build OAuth2 extension for pipeline %s: %w", pipeline.Name, err)
+// countLogOutputs returns the number of outputs of all pipelines, which share the available queue size.
+func countLogOutputs(lps []telemetryv1beta1.LogPipeline) int {
+	count := 0
+	for i := range lps {
+		count += len(pipelines.LogPipelineOutputs(&lps[i])) + len(pipelines.LogPipelineKafkaOutputs(&lps[i])) + len(pipelines.LogPipelineElasticsearchOutputs(&lps[i]))
 	}
 
-	builder.AddExtension(oauth2ExtensionID, oauth2ExtensionConfig, oauth2ExtensionEnvVars)
+	return count
+}
 
-	return nil
+func formatLogServicePipelineID(lp *telemetryv1beta1.LogPipeline) string {
+	return fmt.Sprintf("logs/%s", lp.Name)
 }
 
-// Log pipeline helper functions
+func formatLogEventsServicePipelineID(lp *telemetryv1beta1.LogPipeline) string {
+	return fmt.Sprintf("logs/%s-events", lp.Name)
+}
 
-func shouldEnableLogOAuth2(lp *telemetryv1beta1.LogPipeline) bool {
-	return lp.Spec.Output.OTLP.Authentication != nil && lp.Spec.Output.OTLP.Authentication.OAuth2 != nil
+func formatLogMinSeverityFilterProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDMinSeverityFilterProcessor(pipelines.LogPipelineRef(lp))
 }
 
-func formatLogServicePipelineID(lp *telemetryv1beta1.LogPipeline) string {
-	return fmt.Sprintf("logs/%s", lp.Name)
+func formatK8sEventsFilterID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDK8sEventsFilterProcessor(lp.Name)
 }
 
 func formatNamespaceFilterID(lp *telemetryv1beta1.LogPipeline) string {
 	return common.ComponentIDNamespaceFilterProcessor(lp.Name)
 }
 
+func formatLogElasticsearchIndexProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDElasticsearchIndexProcessor(pipelines.LogPipelineRef(lp))
+}
+
+func formatLogRedactionProcessorID(lp *telemetryv1beta1.LogPipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.LogPipelineRef(lp))
+}
+
 func formatLogUserDefinedTransformProcessorID(lp *telemetryv1beta1.LogPipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.LogPipelineRef(lp))
 }
@@ -298,11 +417,11 @@ func formatLogUserDefinedFilterProcessorID(lp *telemetryv1beta1.LogPipeline) str
 	return common.ComponentIDUserDefinedFilterProcessor(pipelines.LogPipelineRef(lp))
 }
 
-func formatLogOTLPExporterID(lp *telemetryv1beta1.LogPipeline) string {
-	return common.ComponentIDOTLPExporter(lp.Spec.Output.OTLP.Protocol, pipelines.LogPipelineRef(lp))
+func namespaceFilterProcessor(namespaceSelector *telemetryv1beta1.NamespaceSelector) *common.FilterProcessorConfig {
+	return common.LogFilterProcessor([]telemetryv1beta1.FilterSpec{{Conditions: namespaceFilterConditions(namespaceSelector)}})
 }
 
-func namespaceFilterProcessor(namespaceSelector *telemetryv1beta1.NamespaceSelector) *common.FilterProcessorConfig {
+func namespaceFilterConditions(namespaceSelector *telemetryv1beta1.NamespaceSelector) []string {
 	var filterExpressions []string
 
 	if len(namespaceSelector.Exclude) > 0 {
@@ -327,7 +446,7 @@ func namespaceFilterProcessor(namespaceSelector *telemetryv1beta1.NamespaceSelec
 		filterExpressions = append(filterExpressions, includeNamespacesExpr)
 	}
 
-	return common.LogFilterProcessor([]telemetryv1beta1.FilterSpec{{Conditions: filterExpressions}})
+	return filterExpressions
 }
 
 func namespacesConditions(namespaces []string) []string {
@@ -352,3 +471,67 @@ func dropIfInputSourceOTLPProcessor() *common.FilterProcessorConfig {
 func shouldFilterByNamespace(namespaceSelector *telemetryv1beta1.NamespaceSelector) bool {
 	return namespaceSelector != nil && (len(namespaceSelector.Include) > 0 || len(namespaceSelector.Exclude) > 0)
 }
+
+// k8sEventsObjectKindResourceAttributes maps the kinds of the objects involved in Kubernetes events to the resource attributes identifying such objects
+var k8sEventsObjectKindResourceAttributes = []struct {
+	kind      string
+	attribute string
+}{
+	{kind: "Pod", attribute: "k8s.pod.name"},
+	{kind: "Node", attribute: "k8s.node.name"},
+	{kind: "Deployment", attribute: "k8s.deployment.name"},
+	{kind: "ReplicaSet", attribute: "k8s.replicaset.name"},
+	{kind: "StatefulSet", attribute: "k8s.statefulset.name"},
+	{kind: "DaemonSet", attribute: "k8s.daemonset.name"},
+	{kind: "Job", attribute: "k8s.job.name"},
+	{kind: "CronJob", attribute: "k8s.cronjob.name"},
+}
+
+// k8sEventsResourceAttributesStatements moves the namespace of an event to the resource, and identifies the involved object with the resource attributes used for other logs
+func k8sEventsResourceAttributesStatements() []common.TransformProcessorStatements {
+	statements := []string{
+		fmt.Sprintf("set(%s, log.attributes[\"%s\"]) where log.attributes[\"%s\"] != nil", common.ResourceAttribute(common.K8sNamespaceName), common.K8sNamespaceName, common.K8sNamespaceName),
+		fmt.Sprintf("delete_key(log.attributes, \"%s\")", common.K8sNamespaceName),
+	}
+
+	for _, object := range k8sEventsObjectKindResourceAttributes {
+		statements = append(statements, fmt.Sprintf("set(%s, %s) where %s",
+			common.ResourceAttribute(object.attribute),
+			common.ResourceAttribute("k8s.object.name"),
+			common.ResourceAttributeEquals("k8s.object.kind", object.kind),
+		))
+	}
+
+	return []common.TransformProcessorStatements{{Statements: statements}}
+}
+
+// k8sEventsFilterConditions returns the conditions for dropping the Kubernetes events not selected by the events input.
+// Like for the runtime input, events of system namespaces are dropped unless namespaces are selected explicitly.
+func k8sEventsFilterConditions(input *telemetryv1beta1.LogPipelineEventsInput) []string {
+	namespaceSelector := input.Namespaces
+	if namespaceSelector == nil {
+		namespaceSelector = &telemetryv1beta1.NamespaceSelector{Exclude: namespaces.System()}
+	}
+
+	conditions := namespaceFilterConditions(namespaceSelector)
+
+	if len(input.Types) > 0 {
+		var typeConditions []string
+		for _, eventType := range input.Types {
+			typeConditions = append(typeConditions, fmt.Sprintf("log.severity_text == \"%s\"", eventType))
+		}
+
+		conditions = append(conditions, common.Not(common.JoinWithOr(typeConditions...)))
+	}
+
+	if len(input.Reasons) > 0 {
+		var reasonConditions []string
+		for _, reason := range input.Reasons {
+			reasonConditions = append(reasonConditions, fmt.Sprintf("log.attributes[\"k8s.event.reason\"] == \"%s\"", reason))
+		}
+
+		conditions = append(conditions, common.Not(common.JoinWithOr(reasonConditions...)))
+	}
+
+	return conditions
+}
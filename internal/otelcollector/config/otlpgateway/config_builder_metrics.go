@@ -3,21 +3,28 @@ package otlpgateway
 import (
 	"context"
 	"fmt"
+	"time"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
 	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
 	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
 )
 
+const (
+	defaultPrometheusExpiration = 5 * time.Minute
+	defaultPrometheusStaleness  = 5 * time.Minute
+)
+
 // buildMetricPipelines builds metric pipeline configuration and adds it to the shared config.
 // Unlike trace/log which use flat per-pipeline pipelines, metrics use a 3-stage architecture:
 // input pipelines → enrichment pipeline → per-pipeline output pipelines (connected via forward connectors).
 func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], opts BuildOptions) error {
-	pipelines := opts.MetricPipelines
-	if len(pipelines) == 0 {
+	metricPipelines := opts.MetricPipelines
+	if len(metricPipelines) == 0 {
 		return nil
 	}
 
@@ -31,7 +38,8 @@ func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.Comp
 		nil,
 	)
 
-	queueSize := common.BatchingMaxQueueSize / len(pipelines)
+	// Pipelines with only a Prometheus output have no sending queue, so at least one queue is assumed to avoid a division by zero
+	queueSize := common.BatchingMaxQueueSize / max(countMetricOutputs(metricPipelines), 1)
 
 	// Input pipeline: OTLP receiver
 	if err := builder.AddServicePipeline(ctx, nil, "metrics/input-otlp",
@@ -67,26 +75,34 @@ func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.Comp
 	}
 
 	// Per-pipeline output pipelines
-	for _, pipeline := range pipelines {
+	for _, pipeline := range metricPipelines {
 		outputPipelineID := formatMetricOutputServicePipelineID(&pipeline)
 
-		if shouldEnableMetricOAuth2(&pipeline) {
-			if err := b.addMetricOAuth2Extension(ctx, builder, &pipeline); err != nil {
-				return err
-			}
+		if err := builder.AddOAuth2Extensions(ctx, b.Reader, pipelines.MetricPipelineOutputs(&pipeline)); err != nil {
+			return err
 		}
 
-		if err := builder.AddServicePipeline(ctx, &pipeline, outputPipelineID,
+		outputFuncs := []buildMetricComponentFunc{
 			b.addMetricReceiverForEnrichmentForwarder(builder),
+			b.addMetricReceiversForTraceConnectors(builder, opts),
 			b.addMetricDropOTLPIfInputDisabledProcessor(builder),
 			b.addMetricOTLPNamespaceFilterProcessor(builder),
 			b.addMetricDropKymaAttributesProcessor(builder),
+			b.addMetricRedactionProcessor(builder),
 			b.addMetricUserDefinedTransformProcessor(builder),
 			b.addMetricUserDefinedFilterProcessor(builder),
+		}
+		exportFuncs := []buildMetricComponentFunc{
 			b.addMetricCumulativeToDeltaProcessor(builder),
+			b.addMetricDeltaToCumulativeProcessor(builder),
 			b.addMetricBatchProcessor(builder),
-			b.addMetricOTLPExporter(builder, queueSize),
-		); err != nil {
+			b.addMetricOTLPExporters(builder, queueSize),
+			b.addMetricKafkaExporters(builder, queueSize),
+			b.addMetricPrometheusRemoteWriteExporters(builder, queueSize),
+			b.addMetricPrometheusExporter(builder, metricPipelines),
+		}
+
+		if err := addMetricOutputServicePipelines(ctx, builder, &pipeline, outputPipelineID, outputFuncs, exportFuncs); err != nil {
 			return fmt.Errorf("failed to add metric output service pipeline: %w", err)
 		}
 	}
@@ -94,6 +110,16 @@ func (b *Builder) buildMetricPipelines(ctx context.Context, builder *common.Comp
 	return nil
 }
 
+// addMetricOutputServicePipelines adds the output service pipeline of a metric pipeline.
+// If the pipeline has aggregations, the metrics to be aggregated take a detour through an aggregation service pipeline, which merges their resources.
+func addMetricOutputServicePipelines(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], pipeline *telemetryv1beta1.MetricPipeline, outputPipelineID string, outputFuncs, exportFuncs []buildMetricComponentFunc) error {
+	if len(pipeline.Spec.Aggregations) > 0 {
+		return builder.AddMetricAggregationServicePipelines(ctx, pipeline, pipelines.MetricPipelineRef(pipeline), pipeline.Spec.Aggregations, outputPipelineID, outputFuncs, exportFuncs)
+	}
+
+	return builder.AddServicePipeline(ctx, pipeline, outputPipelineID, append(outputFuncs, exportFuncs...)...)
+}
+
 // ======================================================
 // Input pipeline components
 // ======================================================
@@ -253,6 +279,43 @@ func (b *Builder) addMetricReceiverForEnrichmentForwarder(builder *common.Compon
 	)
 }
 
+// addMetricReceiversForTraceConnectors receives the metrics derived by the span metrics and service graph connectors
+// of all TracePipelines targeting the pipeline, so that they pass the filters and transforms of the pipeline.
+func (b *Builder) addMetricReceiversForTraceConnectors(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], opts BuildOptions) buildMetricComponentFunc {
+	return func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline, pipelineID string) error {
+		for i := range opts.TracePipelines {
+			tp := &opts.TracePipelines[i]
+			if tp.Spec.Metrics == nil || tp.Spec.Metrics.MetricPipeline != mp.Name {
+				continue
+			}
+
+			if tp.Spec.Metrics.SpanMetrics != nil {
+				if err := builder.AddReceiver(
+					builder.StaticComponentID(formatTraceSpanMetricsConnectorID(tp)),
+					func(_ *telemetryv1beta1.MetricPipeline) any {
+						return spanMetricsConnectorConfig(tp.Spec.Metrics.SpanMetrics)
+					},
+				)(ctx, mp, pipelineID); err != nil {
+					return err
+				}
+			}
+
+			if tp.Spec.Metrics.ServiceGraph != nil {
+				if err := builder.AddReceiver(
+					builder.StaticComponentID(formatTraceServiceGraphConnectorID(tp)),
+					func(_ *telemetryv1beta1.MetricPipeline) any {
+						return serviceGraphConnectorConfig(tp.Spec.Metrics.ServiceGraph)
+					},
+				)(ctx, mp, pipelineID); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func (b *Builder) addMetricDropOTLPIfInputDisabledProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
 	return builder.AddProcessor(
 		builder.StaticComponentID(common.ComponentIDDropIfInputSourceOTLPProcessor),
@@ -294,6 +357,19 @@ func (b *Builder) addMetricDropKymaAttributesProcessor(builder *common.Component
 	)
 }
 
+func (b *Builder) addMetricRedactionProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		formatMetricRedactionProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Redaction == nil {
+				return nil // No redaction, no processor needed
+			}
+
+			return common.RedactionProcessor(mp.Spec.Redaction)
+		},
+	)
+}
+
 func (b *Builder) addMetricUserDefinedTransformProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
 	return builder.AddProcessor(
 		formatMetricUserDefinedTransformProcessorID,
@@ -340,6 +416,28 @@ func (b *Builder) addMetricCumulativeToDeltaProcessor(builder *common.ComponentB
 	)
 }
 
+// addMetricDeltaToCumulativeProcessor converts delta metrics to cumulative metrics for pipelines with a Prometheus output,
+// because the Prometheus exposition format only supports cumulative temporality.
+func (b *Builder) addMetricDeltaToCumulativeProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
+	return builder.AddProcessor(
+		formatMetricDeltaToCumulativeProcessorID,
+		func(mp *telemetryv1beta1.MetricPipeline) any {
+			if mp.Spec.Output.Prometheus == nil {
+				return nil
+			}
+
+			maxStale := defaultPrometheusStaleness
+			if mp.Spec.Output.Prometheus.Staleness != nil {
+				maxStale = mp.Spec.Output.Prometheus.Staleness.Duration
+			}
+
+			return &common.DeltaToCumulativeProcessorConfig{
+				MaxStale: maxStale.String(),
+			}
+		},
+	)
+}
+
 //nolint:mnd // hardcoded values
 func (b *Builder) addMetricBatchProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline]) buildMetricComponentFunc {
 	return builder.AddProcessor(
@@ -354,43 +452,31 @@ func (b *Builder) addMetricBatchProcessor(builder *common.ComponentBuilder[*tele
 	)
 }
 
-func (b *Builder) addMetricOTLPExporter(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], queueSize int) buildMetricComponentFunc {
-	return builder.AddExporter(
-		formatMetricOTLPExporterID,
-		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
-			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
-				b.Reader,
-				&mp.Spec.Output.OTLP.OTLPOutput,
-				pipelines.MetricPipelineRef(mp),
-				common.NewSendingQueue(queueSize),
-			)
-
-			return otlpExporterBuilder.OTLPExporter(ctx)
-		},
-	)
+func (b *Builder) addMetricOTLPExporters(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], queueSize int) buildMetricComponentFunc {
+	return builder.AddOTLPExporters(b.Reader, pipelines.MetricPipelineOutputs, common.NewSendingQueue(queueSize, common.WithPersistentStorage(sendingQueueStorageDirectory)))
 }
 
-// ======================================================
-// Authentication extensions
-// ======================================================
+func (b *Builder) addMetricKafkaExporters(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], queueSize int) buildMetricComponentFunc {
+	return builder.AddKafkaExporters(b.Reader, pipelines.MetricPipelineKafkaOutputs, common.NewSendingQueue(queueSize))
+}
 
-//nolint:dupl // Acceptable duplication - metric, trace and log OAuth2 extensions follow same pattern
-func (b *Builder) addMetricOAuth2Extension(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], pipeline *telemetryv1beta1.MetricPipeline) error {
-	pipelineRef := pipelines.MetricPipelineRef(pipeline)
-	oauth2ExtensionID := common.ComponentIDOAuth2Extension(pipelineRef)
-
-	oauth2ExtensionConfig, oauth2ExtensionEnvVars, err := common.NewOAuth2ExtensionConfigBuilder(
-		b.Reader,
-		pipeline.Spec.Output.OTLP.Authentication.OAuth2,
-		pipelineRef,
-	).OAuth2Extension(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to build OAuth2 extension for pipeline %s: %w", pipeline.Name, err)
-	}
+func (b *Builder) addMetricPrometheusRemoteWriteExporters(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], queueSize int) buildMetricComponentFunc {
+	return builder.AddPrometheusRemoteWriteExporters(b.Reader, pipelines.MetricPipelinePrometheusRemoteWriteOutputs, common.NewSendingQueue(queueSize))
+}
 
-	builder.AddExtension(oauth2ExtensionID, oauth2ExtensionConfig, oauth2ExtensionEnvVars)
+// addMetricPrometheusExporter adds the Prometheus exporter, which is shared by all pipelines with a Prometheus output.
+// The exporter exposes the metrics of all these pipelines on the same endpoint.
+func (b *Builder) addMetricPrometheusExporter(builder *common.ComponentBuilder[*telemetryv1beta1.MetricPipeline], metricPipelines []telemetryv1beta1.MetricPipeline) buildMetricComponentFunc {
+	return builder.AddExporter(
+		builder.StaticComponentID(common.ComponentIDPrometheusExporter),
+		func(ctx context.Context, mp *telemetryv1beta1.MetricPipeline) (any, common.EnvVars, error) {
+			if mp.Spec.Output.Prometheus == nil {
+				return nil, nil, nil
+			}
 
-	return nil
+			return prometheusExporterConfig(metricPipelines), nil, nil
+		},
+	)
 }
 
 // ======================================================
@@ -446,8 +532,8 @@ func formatMetricOTLPNamespaceFilterID(mp *telemetryv1beta1.MetricPipeline) stri
 	return common.ComponentIDNamespacePerInputFilterProcessor(mp.Name, common.InputSourceOTLP)
 }
 
-func formatMetricOTLPExporterID(pipeline *telemetryv1beta1.MetricPipeline) string {
-	return common.ComponentIDOTLPExporter(pipeline.Spec.Output.OTLP.Protocol, pipelines.MetricPipelineRef(pipeline))
+func formatMetricRedactionProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.MetricPipelineRef(mp))
 }
 
 func formatMetricUserDefinedTransformProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
@@ -458,6 +544,43 @@ func formatMetricUserDefinedFilterProcessorID(mp *telemetryv1beta1.MetricPipelin
 	return common.ComponentIDUserDefinedFilterProcessor(pipelines.MetricPipelineRef(mp))
 }
 
-func shouldEnableMetricOAuth2(mp *telemetryv1beta1.MetricPipeline) bool {
-	return mp.Spec.Output.OTLP.Authentication != nil && mp.Spec.Output.OTLP.Authentication.OAuth2 != nil
+func formatMetricDeltaToCumulativeProcessorID(mp *telemetryv1beta1.MetricPipeline) string {
+	return common.ComponentIDDeltaToCumulativeProcessor(pipelines.MetricPipelineRef(mp))
+}
+
+// prometheusExporterConfig creates the config of the shared Prometheus exporter.
+// Because the exporter serves all pipelines with a Prometheus output, the longest expiration of these pipelines applies.
+func prometheusExporterConfig(mps []telemetryv1beta1.MetricPipeline) *common.PrometheusExporterConfig {
+	var expiration time.Duration
+
+	for i := range mps {
+		output := mps[i].Spec.Output.Prometheus
+		if output == nil {
+			continue
+		}
+
+		outputExpiration := defaultPrometheusExpiration
+		if output.Expiration != nil {
+			outputExpiration = output.Expiration.Duration
+		}
+
+		expiration = max(expiration, outputExpiration)
+	}
+
+	return &common.PrometheusExporterConfig{
+		Endpoint:         fmt.Sprintf("${%s}:%d", common.EnvVarCurrentPodIP, ports.PrometheusExposition),
+		MetricExpiration: expiration.String(),
+	}
+}
+
+// countMetricOutputs returns the number of outputs of all pipelines, which share the available queue size.
+func countMetricOutputs(mps []telemetryv1beta1.MetricPipeline) int {
+	count := 0
+	for i := range mps {
+		count += len(pipelines.MetricPipelineOutputs(&mps[i])) +
+			len(pipelines.MetricPipelineKafkaOutputs(&mps[i])) +
+			len(pipelines.MetricPipelinePrometheusRemoteWriteOutputs(&mps[i]))
+	}
+
+	return count
 }
@@ -5,9 +5,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
@@ -147,6 +149,91 @@ func TestBuild(t *testing.T) {
 				testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
 			},
 		},
+		{
+			name:           "trace-pipelines with tail-based sampling",
+			goldenFileName: "trace-sampling.yaml",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().WithName("test-trace").Build(),
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace-sampled").
+					WithSampling(&telemetryv1beta1.TracePipelineSampling{
+						Tail: &telemetryv1beta1.TraceTailSampling{
+							DecisionWait: &metav1.Duration{Duration: 30 * time.Second},
+							Policies: []telemetryv1beta1.TraceSamplingPolicy{
+								{Name: "errors", StatusCode: &telemetryv1beta1.StatusCodeSamplingPolicy{StatusCodes: []string{"ERROR"}}},
+								{Name: "slow", Latency: &telemetryv1beta1.LatencySamplingPolicy{Threshold: metav1.Duration{Duration: 500 * time.Millisecond}}},
+								{Name: "checkout", Attribute: &telemetryv1beta1.AttributeSamplingPolicy{Key: "http.route", Values: []string{"/checkout.*"}, EnableRegexMatching: true}},
+								{Name: "healthy", Probabilistic: &telemetryv1beta1.ProbabilisticSamplingPolicy{Percentage: 10}},
+							},
+						},
+					}).Build(),
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace-sampled-default").
+					WithSampling(&telemetryv1beta1.TracePipelineSampling{
+						Tail: &telemetryv1beta1.TraceTailSampling{
+							Policies: []telemetryv1beta1.TraceSamplingPolicy{
+								{Name: "healthy", Probabilistic: &telemetryv1beta1.ProbabilisticSamplingPolicy{Percentage: 25}},
+							},
+						},
+					}).Build(),
+			},
+		},
+		{
+			name:           "trace-pipelines with head-based sampling",
+			goldenFileName: "trace-head-sampling.yaml",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace").
+					WithSampling(&telemetryv1beta1.TracePipelineSampling{
+						Head: &telemetryv1beta1.TraceHeadSampling{Percentage: 10},
+					}).Build(),
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace-overrides").
+					WithSampling(&telemetryv1beta1.TracePipelineSampling{
+						Head: &telemetryv1beta1.TraceHeadSampling{
+							Percentage: 50,
+							Overrides: []telemetryv1beta1.TraceHeadSamplingOverride{
+								{Namespaces: telemetryv1beta1.NamespaceSelector{Include: []string{"payments"}}, Percentage: 100},
+								{Namespaces: telemetryv1beta1.NamespaceSelector{Include: []string{"load-test"}}, Percentage: 5},
+								{Namespaces: telemetryv1beta1.NamespaceSelector{Exclude: []string{"production"}}, Percentage: 0},
+							},
+						},
+					}).Build(),
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace-keep-all").
+					WithSampling(&telemetryv1beta1.TracePipelineSampling{
+						Head: &telemetryv1beta1.TraceHeadSampling{Percentage: 100},
+					}).Build(),
+			},
+		},
+		{
+			name:           "trace-pipelines with span metrics and service graph",
+			goldenFileName: "trace-metrics.yaml",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace").
+					WithMetrics(&telemetryv1beta1.TracePipelineMetrics{
+						MetricPipeline: "test-metric",
+						SpanMetrics:    &telemetryv1beta1.SpanMetrics{Dimensions: []string{"http.route"}},
+						ServiceGraph:   &telemetryv1beta1.ServiceGraphMetrics{},
+					}).Build(),
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace-missing-target").
+					WithMetrics(&telemetryv1beta1.TracePipelineMetrics{
+						MetricPipeline: "non-existing",
+						SpanMetrics:    &telemetryv1beta1.SpanMetrics{},
+					}).Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric").
+					WithFilter(telemetryv1beta1.FilterSpec{
+						Conditions: []string{"metric.name == \"traces.span.metrics.duration\""},
+					}).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					Build(),
+			},
+		},
 		// Comprehensive test cases
 		{
 			name:           "single pipeline",
@@ -490,6 +577,94 @@ func TestBuild(t *testing.T) {
 					WithOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
 			},
 		},
+		{
+			name:           "log-pipelines with events input",
+			goldenFileName: "log-events.yaml",
+			moduleVersion:  "1.0.0",
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test-log-1").
+					WithOTLPInput(false).
+					WithEventsInput(true).
+					WithOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
+				testutils.NewLogPipelineBuilder().
+					WithName("test-log-2").
+					WithEventsInput(true, testutils.IncludeNamespaces("default")).
+					WithEventsFilter([]telemetryv1beta1.EventType{telemetryv1beta1.EventTypeWarning}, []string{"OOMKilling", "Evicted"}).
+					WithOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
+			},
+		},
+		{
+			name:           "log-pipelines with min severity",
+			goldenFileName: "log-min-severity.yaml",
+			moduleVersion:  "1.0.0",
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test-log").
+					WithOTLPInputMinSeverity(&telemetryv1beta1.LogPipelineMinSeverity{
+						Level: telemetryv1beta1.LogSeverityWarn,
+						Overrides: []telemetryv1beta1.LogPipelineMinSeverityOverride{
+							{Namespaces: telemetryv1beta1.NamespaceSelector{Include: []string{"payments", "checkout"}}, Level: telemetryv1beta1.LogSeverityInfo},
+						},
+					}).
+					WithOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
+			},
+		},
+		{
+			name:           "metric-pipelines with aggregations",
+			goldenFileName: "metric-aggregations.yaml",
+			moduleVersion:  "1.0.0",
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric").
+					WithAggregation(telemetryv1beta1.MetricAggregation{
+						MetricNames: []string{"http_server_duration"},
+						DropLabels:  []string{"net.sock.peer.addr", "net.sock.peer.port"},
+						Type:        telemetryv1beta1.MetricAggregationTypeSum,
+					}).
+					WithAggregation(telemetryv1beta1.MetricAggregation{
+						MetricNamePattern: "^queue_.+_size$",
+						KeepLabels:        []string{"queue"},
+						Type:              telemetryv1beta1.MetricAggregationTypeMax,
+					}).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					Build(),
+			},
+		},
+		{
+			name:           "all-signals-redaction",
+			goldenFileName: "all-signals-redaction.yaml",
+			moduleVersion:  "1.0.0",
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test-log").
+					WithEventsInput(true).
+					WithRedaction(&telemetryv1beta1.RedactionSpec{
+						Detectors:   []telemetryv1beta1.RedactionDetector{telemetryv1beta1.RedactionDetectorEmail, telemetryv1beta1.RedactionDetectorJWT},
+						AllowedKeys: []string{"user.email"},
+					}).
+					WithTransform(telemetryv1beta1.TransformSpec{
+						Statements: []string{"set(attributes[\"user.email\"], \"redacted\")"},
+					}).
+					WithOTLPOutput(testutils.OTLPEndpoint("https://localhost")).Build(),
+			},
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace").
+					WithRedaction(&telemetryv1beta1.RedactionSpec{
+						Detectors: []telemetryv1beta1.RedactionDetector{telemetryv1beta1.RedactionDetectorCreditCard, telemetryv1beta1.RedactionDetectorIBAN},
+					}).Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric").
+					WithRedaction(&telemetryv1beta1.RedactionSpec{
+						Patterns: []string{`customer-[0-9]{6}`},
+					}).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://localhost")).
+					Build(),
+			},
+		},
 		{
 			name:           "mixed pipelines",
 			goldenFileName: "mixed-pipelines.yaml",
@@ -519,6 +694,179 @@ func TestBuild(t *testing.T) {
 				testutils.NewMetricPipelineBuilder().WithName("metric-backend-2").WithOTLPInput(true).WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://backend-2.example.com")).Build(),
 			},
 		},
+		{
+			name:           "all-signals-additional-outputs",
+			goldenFileName: "all-signals-additional-outputs.yaml",
+			moduleVersion:  "1.0.0",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("trace-fan-out").
+					WithOTLPOutput(testutils.OTLPEndpoint("https://vendor.example.com")).
+					WithAdditionalOTLPOutput("archive", testutils.OTLPEndpoint("https://archive.example.com"), testutils.OTLPProtocol("http")).
+					WithAdditionalOTLPOutput("audit", testutils.OTLPEndpoint("https://audit.example.com"), testutils.OTLPOAuth2(
+						testutils.OAuth2ClientID("client-id"),
+						testutils.OAuth2ClientSecret("client-secret"),
+						testutils.OAuth2TokenURL("https://auth.example.com/oauth2/token"),
+					)).Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("log-fan-out").
+					WithOTLPOutput(testutils.OTLPEndpoint("https://vendor.example.com")).
+					WithAdditionalOTLPOutput("archive", testutils.OTLPEndpoint("https://archive.example.com")).Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("metric-fan-out").
+					WithOTLPInput(true).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://vendor.example.com")).
+					WithAdditionalOTLPOutput("archive", testutils.OTLPEndpoint("https://archive.example.com")).Build(),
+			},
+		},
+		{
+			name:           "all-signals-failover-output",
+			goldenFileName: "all-signals-failover-output.yaml",
+			moduleVersion:  "1.0.0",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("trace-failover").
+					WithOTLPOutput(testutils.OTLPEndpoint("https://primary.example.com")).
+					WithFailoverOTLPOutput(testutils.OTLPEndpoint("https://secondary.example.com"), testutils.OTLPProtocol("http")).
+					WithAdditionalOTLPOutput("archive", testutils.OTLPEndpoint("https://archive.example.com")).Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("log-failover").
+					WithOTLPOutput(testutils.OTLPEndpoint("https://primary.example.com")).
+					WithFailoverOTLPOutput(testutils.OTLPEndpoint("https://secondary.example.com")).Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("metric-failover").
+					WithOTLPInput(true).
+					WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://primary.example.com")).
+					WithFailoverOTLPOutput(testutils.OTLPEndpoint("https://secondary.example.com")).Build(),
+			},
+		},
+		{
+			name:           "all-signals-kafka-output",
+			goldenFileName: "all-signals-kafka-output.yaml",
+			moduleVersion:  "1.0.0",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("trace-kafka").
+					WithKafkaOutput(
+						testutils.KafkaBrokers("kafka-0.example.com:9093", "kafka-1.example.com:9093"),
+						testutils.KafkaTopic("traces"),
+						testutils.KafkaPartitionBy(telemetryv1beta1.KafkaPartitionByTraceID),
+						testutils.KafkaSASL(telemetryv1beta1.KafkaSASLMechanismSCRAMSHA256, "user", "password"),
+						testutils.KafkaClientTLS(&telemetryv1beta1.OutputTLS{CA: &telemetryv1beta1.ValueType{Value: "ca"}}),
+					).Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("log-kafka").
+					WithKafkaOutput(
+						testutils.KafkaTopic("logs"),
+						testutils.KafkaEncoding(telemetryv1beta1.KafkaEncodingOTLPJSON),
+						testutils.KafkaPartitionBy(telemetryv1beta1.KafkaPartitionByResourceAttributes),
+					).Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("metric-kafka").
+					WithOTLPInput(true).
+					WithKafkaOutput(
+						testutils.KafkaTopic("metrics"),
+						testutils.KafkaPartitionBy(telemetryv1beta1.KafkaPartitionByResourceAttributes),
+					).Build(),
+			},
+		},
+		{
+			name:           "metric pipeline with prometheus remote-write output",
+			goldenFileName: "metric-prometheus-remote-write.yaml",
+			moduleVersion:  "1.0.0",
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric").
+					WithOTLPInput(true).
+					WithPrometheusRemoteWriteOutput(
+						testutils.OTLPEndpoint("https://prometheus.example.com/api/v1/write"),
+						testutils.OTLPBasicAuth("user", "password"),
+						testutils.OTLPCustomHeader("X-Scope-OrgID", "tenant", ""),
+					).
+					WithResourceAttributesToLabels(true).
+					Build(),
+			},
+		},
+		{
+			name:           "metric pipelines with prometheus output",
+			goldenFileName: "metric-prometheus-exposition.yaml",
+			moduleVersion:  "1.0.0",
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric").
+					WithOTLPInput(true).
+					WithPrometheusOutput().
+					Build(),
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric-custom").
+					WithOTLPInput(true).
+					WithPrometheusOutput(
+						testutils.PrometheusExpiration(10*time.Minute),
+						testutils.PrometheusStaleness(time.Minute),
+					).
+					Build(),
+			},
+		},
+		{
+			name:           "log pipelines with loki and elasticsearch outputs",
+			goldenFileName: "log-loki-elasticsearch.yaml",
+			moduleVersion:  "1.0.0",
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test-loki").
+					WithLokiOutput(
+						testutils.OTLPEndpoint("https://loki.example.com"),
+						testutils.OTLPCustomHeader("X-Scope-OrgID", "tenant", ""),
+					).
+					Build(),
+				testutils.NewLogPipelineBuilder().
+					WithName("test-elasticsearch").
+					WithElasticsearchOutput("logs", "k8s.namespace.name",
+						testutils.OTLPEndpoint("https://elasticsearch.example.com:9200"),
+						testutils.OTLPBasicAuth("user", "password"),
+					).
+					Build(),
+			},
+		},
+		{
+			name:           "pipeline with persistent queue",
+			goldenFileName: "persistent-queue.yaml",
+			moduleVersion:  "1.0.0",
+			tracePipelines: []telemetryv1beta1.TracePipeline{
+				testutils.NewTracePipelineBuilder().
+					WithName("test-trace").
+					WithOTLPOutput(
+						testutils.OTLPPersistentQueue(true),
+					).Build(),
+			},
+			logPipelines: []telemetryv1beta1.LogPipeline{
+				testutils.NewLogPipelineBuilder().
+					WithName("test-log").
+					WithOTLPOutput(
+						testutils.OTLPPersistentQueue(true),
+					).Build(),
+			},
+			metricPipelines: []telemetryv1beta1.MetricPipeline{
+				testutils.NewMetricPipelineBuilder().
+					WithName("test-metric").
+					WithOTLPInput(true).
+					WithMetricPipelineOTLPOutput(
+						testutils.OTLPPersistentQueue(false),
+					).Build(),
+			},
+		},
 		{
 			name:           "pipeline with snappy compression",
 			goldenFileName: "compression.yaml",
@@ -3,29 +3,37 @@ package otlpgateway
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	commonresources "github.com/kyma-project/telemetry-manager/internal/resources/common"
+	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 )
 
 // buildTracePipelines builds trace pipeline configuration and adds it to the shared config.
 func (b *Builder) buildTracePipelines(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) error {
-	pipelines := opts.TracePipelines
-	if len(pipelines) == 0 {
+	tracePipelines := opts.TracePipelines
+	if len(tracePipelines) == 0 {
 		return nil
 	}
 
-	queueSize := common.BatchingMaxQueueSize / len(pipelines)
+	queueSize := common.BatchingMaxQueueSize / countTraceOutputs(tracePipelines)
+	samplingRoutingConfig := traceSamplingRoutingConnectorConfig(tracePipelines)
 
-	for _, pipeline := range pipelines {
+	for _, pipeline := range tracePipelines {
 		pipelineID := formatTraceServicePipelineID(&pipeline)
 
-		if shouldEnableTraceOAuth2(&pipeline) {
-			if err := b.addTraceOAuth2Extension(ctx, builder, &pipeline); err != nil {
-				return err
-			}
+		if err := builder.AddOAuth2Extensions(ctx, b.Reader, pipelines.TracePipelineOutputs(&pipeline)); err != nil {
+			return err
+		}
+
+		// Sampled pipelines hand over spans to the gateway instance owning the trace, which exports them after the sampling decision
+		exporter := b.addTraceExporters(builder, queueSize)
+		if shouldEnableTraceTailSampling(&pipeline) {
+			exporter = b.addTraceLoadBalancingExporter(builder, opts)
 		}
 
 		if err := builder.AddServicePipeline(ctx, &pipeline, pipelineID,
@@ -39,13 +47,47 @@ func (b *Builder) buildTracePipelines(ctx context.Context, builder *common.Compo
 			b.addTraceInsertClusterAttributesProcessor(builder, opts),
 			b.addTraceServiceEnrichmentProcessor(builder, opts),
 			b.addTraceDropKymaAttributesProcessor(builder),
+			b.addTraceHeadSamplingProcessor(builder),
+			b.addTraceRedactionProcessor(builder),
 			b.addTraceUserDefinedTransformProcessor(builder),
 			b.addTraceUserDefinedFilterProcessor(builder),
 			b.addTraceBatchProcessor(builder),
-			b.addTraceOTLPExporter(builder, queueSize),
+			exporter,
+			b.addTraceSpanMetricsConnector(builder, opts),
+			b.addTraceServiceGraphConnector(builder, opts),
 		); err != nil {
 			return fmt.Errorf("failed to add trace service pipeline: %w", err)
 		}
+
+		if !shouldEnableTraceTailSampling(&pipeline) {
+			continue
+		}
+
+		if err := builder.AddServicePipeline(ctx, &pipeline, formatTraceSamplingServicePipelineID(&pipeline),
+			b.addTraceSamplingRoutingReceiver(builder, samplingRoutingConfig),
+			b.addTraceTailSamplingProcessor(builder),
+			b.addTraceBatchProcessor(builder),
+			b.addTraceExporters(builder, queueSize),
+		); err != nil {
+			return fmt.Errorf("failed to add trace sampling service pipeline: %w", err)
+		}
+	}
+
+	firstSampled := slices.IndexFunc(tracePipelines, func(tp telemetryv1beta1.TracePipeline) bool {
+		return shouldEnableTraceTailSampling(&tp)
+	})
+	if firstSampled < 0 {
+		return nil
+	}
+
+	// All sampled pipelines share a single receiver for the load-balanced spans, the routing connector dispatches them
+	// to the sampling service pipeline of the originating TracePipeline
+	if err := builder.AddServicePipeline(ctx, &tracePipelines[firstSampled], traceSamplingInputServicePipelineID,
+		b.addTraceSamplingReceiver(builder),
+		b.addTraceMemoryLimiterProcessor(builder),
+		b.addTraceSamplingRoutingExporter(builder, samplingRoutingConfig),
+	); err != nil {
+		return fmt.Errorf("failed to add trace sampling input service pipeline: %w", err)
 	}
 
 	return nil
@@ -162,6 +204,37 @@ func (b *Builder) addTraceDropKymaAttributesProcessor(builder *common.ComponentB
 	)
 }
 
+func (b *Builder) addTraceHeadSamplingProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
+	return builder.AddProcessor(
+		formatTraceHeadSamplingProcessorID,
+		func(tp *telemetryv1beta1.TracePipeline) any {
+			if tp.Spec.Sampling == nil || tp.Spec.Sampling.Head == nil {
+				return nil
+			}
+
+			processorConfig := headSamplingProcessorConfig(tp.Spec.Sampling.Head)
+			if processorConfig == nil {
+				return nil
+			}
+
+			return processorConfig
+		},
+	)
+}
+
+func (b *Builder) addTraceRedactionProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
+	return builder.AddProcessor(
+		formatTraceRedactionProcessorID,
+		func(tp *telemetryv1beta1.TracePipeline) any {
+			if tp.Spec.Redaction == nil {
+				return nil // No redaction, no processor needed
+			}
+
+			return common.RedactionProcessor(tp.Spec.Redaction)
+		},
+	)
+}
+
 func (b *Builder) addTraceUserDefinedTransformProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
 	return builder.AddProcessor(
 		formatTraceUserDefinedTransformProcessorID,
@@ -204,51 +277,292 @@ func (b *Builder) addTraceBatchProcessor(builder *common.ComponentBuilder[*telem
 	)
 }
 
-func (b *Builder) addTraceOTLPExporter(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], queueSize int) buildTraceComponentFunc {
+// addTraceExporters adds an exporter for each OTLP and Kafka output of the pipeline.
+func (b *Builder) addTraceExporters(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], queueSize int) buildTraceComponentFunc {
+	addOTLPExporters := builder.AddOTLPExporters(b.Reader, pipelines.TracePipelineOutputs, common.NewSendingQueue(queueSize, common.WithPersistentStorage(sendingQueueStorageDirectory)))
+	addKafkaExporters := builder.AddKafkaExporters(b.Reader, pipelines.TracePipelineKafkaOutputs, common.NewSendingQueue(queueSize))
+
+	return func(ctx context.Context, tp *telemetryv1beta1.TracePipeline, pipelineID string) error {
+		if err := addOTLPExporters(ctx, tp, pipelineID); err != nil {
+			return err
+		}
+
+		return addKafkaExporters(ctx, tp, pipelineID)
+	}
+}
+
+func (b *Builder) addTraceLoadBalancingExporter(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
 	return builder.AddExporter(
-		formatTraceOTLPExporterID,
+		formatTraceLoadBalancingExporterID,
 		func(ctx context.Context, tp *telemetryv1beta1.TracePipeline) (any, common.EnvVars, error) {
-			otlpExporterBuilder := common.NewOTLPExporterConfigBuilder(
-				b.Reader,
-				tp.Spec.Output.OTLP,
-				pipelines.TracePipelineRef(tp),
-				common.NewSendingQueue(queueSize),
-			)
-
-			return otlpExporterBuilder.OTLPExporter(ctx)
+			return &LoadBalancingExporterConfig{
+				RoutingKey: "traceID",
+				Protocol: LoadBalancingProtocol{
+					OTLP: common.OTLPExporterConfig{
+						Headers: map[string]string{traceSamplingPipelineHeader: tp.Name},
+						TLS:     common.TLS{Insecure: true},
+					},
+				},
+				Resolver: LoadBalancingResolver{
+					K8s: LoadBalancingK8sResolver{
+						Service: fmt.Sprintf("%s.%s", names.OTLPGatewayTraceSamplingService, opts.GatewayNamespace),
+						Ports:   []int32{ports.OTLPTraceSampling},
+					},
+				},
+			}, nil, nil
 		},
 	)
 }
 
-//nolint:dupl // Acceptable duplication - trace and log OAuth2 extensions follow same pattern
-func (b *Builder) addTraceOAuth2Extension(ctx context.Context, builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], pipeline *telemetryv1beta1.TracePipeline) error {
-	pipelineRef := pipelines.TracePipelineRef(pipeline)
-	oauth2ExtensionID := common.ComponentIDOAuth2Extension(pipelineRef)
+// addTraceSpanMetricsConnector adds the span metrics connector, which derives RED metrics from the spans of the pipeline
+// and hands them over to the output pipeline of the target MetricPipeline.
+func (b *Builder) addTraceSpanMetricsConnector(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
+	return builder.AddExporter(
+		formatTraceSpanMetricsConnectorID,
+		func(ctx context.Context, tp *telemetryv1beta1.TracePipeline) (any, common.EnvVars, error) {
+			if !shouldDeriveTraceMetrics(tp, opts.MetricPipelines) || tp.Spec.Metrics.SpanMetrics == nil {
+				return nil, nil, nil
+			}
 
-	oauth2ExtensionConfig, oauth2ExtensionEnvVars, err := common.NewOAuth2ExtensionConfigBuilder(
-		b.Reader,
-		pipeline.Spec.Output.OTLP.Authentication.OAuth2,
-		pipelineRef,
-	).OAuth2Extension(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to build OAuth2 extension for pipeline %s: %w", pipeline.Name, err)
-	}
+			return spanMetricsConnectorConfig(tp.Spec.Metrics.SpanMetrics), nil, nil
+		},
+	)
+}
 
-	builder.AddExtension(oauth2ExtensionID, oauth2ExtensionConfig, oauth2ExtensionEnvVars)
+// addTraceServiceGraphConnector adds the service graph connector, which derives metrics about the requests between services
+// from the spans of the pipeline and hands them over to the output pipeline of the target MetricPipeline.
+func (b *Builder) addTraceServiceGraphConnector(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], opts BuildOptions) buildTraceComponentFunc {
+	return builder.AddExporter(
+		formatTraceServiceGraphConnectorID,
+		func(ctx context.Context, tp *telemetryv1beta1.TracePipeline) (any, common.EnvVars, error) {
+			if !shouldDeriveTraceMetrics(tp, opts.MetricPipelines) || tp.Spec.Metrics.ServiceGraph == nil {
+				return nil, nil, nil
+			}
 
-	return nil
+			return serviceGraphConnectorConfig(tp.Spec.Metrics.ServiceGraph), nil, nil
+		},
+	)
+}
+
+func (b *Builder) addTraceSamplingReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDTraceSamplingReceiver),
+		func(_ *telemetryv1beta1.TracePipeline) any {
+			return &common.OTLPReceiverConfig{
+				Protocols: common.ReceiverProtocols{
+					GRPC: common.Endpoint{
+						Endpoint:        fmt.Sprintf("${%s}:%d", common.EnvVarCurrentPodIP, ports.OTLPTraceSampling),
+						IncludeMetadata: true,
+					},
+				},
+			}
+		},
+	)
+}
+
+func (b *Builder) addTraceSamplingRoutingExporter(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], routingConfig *common.RoutingConnectorConfig) buildTraceComponentFunc {
+	return builder.AddExporter(
+		builder.StaticComponentID(common.ComponentIDTraceSamplingRoutingConnector),
+		func(ctx context.Context, _ *telemetryv1beta1.TracePipeline) (any, common.EnvVars, error) {
+			return routingConfig, nil, nil
+		},
+	)
+}
+
+func (b *Builder) addTraceSamplingRoutingReceiver(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline], routingConfig *common.RoutingConnectorConfig) buildTraceComponentFunc {
+	return builder.AddReceiver(
+		builder.StaticComponentID(common.ComponentIDTraceSamplingRoutingConnector),
+		func(_ *telemetryv1beta1.TracePipeline) any {
+			return routingConfig
+		},
+	)
+}
+
+func (b *Builder) addTraceTailSamplingProcessor(builder *common.ComponentBuilder[*telemetryv1beta1.TracePipeline]) buildTraceComponentFunc {
+	return builder.AddProcessor(
+		formatTraceTailSamplingProcessorID,
+		func(tp *telemetryv1beta1.TracePipeline) any {
+			return tailSamplingProcessorConfig(tp.Spec.Sampling.Tail)
+		},
+	)
 }
 
 // Trace pipeline helper functions
 
-func shouldEnableTraceOAuth2(tp *telemetryv1beta1.TracePipeline) bool {
-	return tp.Spec.Output.OTLP.Authentication != nil && tp.Spec.Output.OTLP.Authentication.OAuth2 != nil
+const (
+	// traceSamplingPipelineHeader carries the name of the originating TracePipeline when spans are load-balanced across gateway instances
+	traceSamplingPipelineHeader         = "x-kyma-trace-pipeline"
+	traceSamplingInputServicePipelineID = "traces/sampling-input"
+	defaultTraceSamplingDecisionWait    = "10s"
+
+	maxSamplingPercentage = 100
+	// traceIDBucketCount is the number of distinct values of the last 4 hex digits of a trace ID
+	traceIDBucketCount = 0x10000
+)
+
+func shouldEnableTraceTailSampling(tp *telemetryv1beta1.TracePipeline) bool {
+	return tp.Spec.Sampling != nil && tp.Spec.Sampling.Tail != nil && len(tp.Spec.Sampling.Tail.Policies) > 0
+}
+
+// traceSamplingRoutingConnectorConfig returns the routing connector configuration which dispatches load-balanced spans
+// to the sampling service pipeline of their TracePipeline. Returns nil if no pipeline has sampling enabled.
+func traceSamplingRoutingConnectorConfig(tps []telemetryv1beta1.TracePipeline) *common.RoutingConnectorConfig {
+	var table []common.RoutingConnectorTableEntry
+
+	for i := range tps {
+		if !shouldEnableTraceTailSampling(&tps[i]) {
+			continue
+		}
+
+		table = append(table, common.RoutingConnectorTableEntry{
+			Statement: fmt.Sprintf("route() where request[\"%s\"] == \"%s\"", traceSamplingPipelineHeader, tps[i].Name),
+			Pipelines: []string{formatTraceSamplingServicePipelineID(&tps[i])},
+			Context:   "request",
+		})
+	}
+
+	if len(table) == 0 {
+		return nil
+	}
+
+	return &common.RoutingConnectorConfig{
+		DefaultPipelines: []string{},
+		ErrorMode:        "ignore",
+		Table:            table,
+	}
+}
+
+// headSamplingProcessorConfig returns a filter processor configuration that implements probabilistic head-based sampling.
+// The sampling decision is derived from the last 16 bits of the trace ID, which are random for W3C trace IDs. This way, all spans
+// of a trace get the same decision on every gateway instance. Namespace overrides are evaluated in order, and the first matching one applies.
+// Returns nil if all traces are kept.
+func headSamplingProcessorConfig(head *telemetryv1beta1.TraceHeadSampling) *common.FilterProcessorConfig {
+	var (
+		dropConditions []string
+		// conditions that are true if none of the previous overrides matches
+		notMatchedByPrevious []string
+	)
+
+	for _, override := range head.Overrides {
+		matches, notMatches := common.NamespaceSelectorConditions(&override.Namespaces)
+
+		if override.Percentage < maxSamplingPercentage {
+			dropConditions = append(dropConditions, common.JoinWithAnd(
+				slices.Concat(notMatchedByPrevious, []string{matches, traceIDOutsideSamplingPercentage(override.Percentage)})...,
+			))
+		}
+
+		notMatchedByPrevious = append(notMatchedByPrevious, notMatches)
+	}
+
+	if head.Percentage < maxSamplingPercentage {
+		dropConditions = append(dropConditions, common.JoinWithAnd(
+			slices.Concat(notMatchedByPrevious, []string{traceIDOutsideSamplingPercentage(head.Percentage)})...,
+		))
+	}
+
+	if len(dropConditions) == 0 {
+		return nil
+	}
+
+	return common.TraceFilterProcessor([]telemetryv1beta1.FilterSpec{{Conditions: dropConditions}})
+}
+
+// traceIDOutsideSamplingPercentage returns an OTTL condition that matches spans whose trace ID falls outside the given sampling percentage.
+// The hex encoded suffix of the trace ID is compared lexicographically against the threshold, which is equivalent to a numeric comparison.
+func traceIDOutsideSamplingPercentage(percentage int32) string {
+	threshold := int(percentage) * traceIDBucketCount / maxSamplingPercentage
+	return fmt.Sprintf("Substring(span.trace_id.string, 28, 4) >= \"%04x\"", threshold)
+}
+
+func tailSamplingProcessorConfig(sampling *telemetryv1beta1.TraceTailSampling) *TailSamplingProcessorConfig {
+	decisionWait := defaultTraceSamplingDecisionWait
+	if sampling.DecisionWait != nil {
+		decisionWait = sampling.DecisionWait.Duration.String()
+	}
+
+	policies := make([]TailSamplingPolicy, 0, len(sampling.Policies))
+
+	for _, p := range sampling.Policies {
+		policy := TailSamplingPolicy{Name: p.Name}
+
+		switch {
+		case p.Probabilistic != nil:
+			policy.Type = "probabilistic"
+			policy.Probabilistic = &TailSamplingProbabilisticConfig{SamplingPercentage: p.Probabilistic.Percentage}
+		case p.Latency != nil:
+			policy.Type = "latency"
+			policy.Latency = &TailSamplingLatencyConfig{ThresholdMs: p.Latency.Threshold.Milliseconds()}
+		case p.StatusCode != nil:
+			policy.Type = "status_code"
+			policy.StatusCode = &TailSamplingStatusCodeConfig{StatusCodes: p.StatusCode.StatusCodes}
+		case p.Attribute != nil:
+			policy.Type = "string_attribute"
+			policy.StringAttribute = &TailSamplingStringAttributeConfig{
+				Key:                  p.Attribute.Key,
+				Values:               p.Attribute.Values,
+				EnabledRegexMatching: p.Attribute.EnableRegexMatching,
+			}
+		default:
+			continue
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return &TailSamplingProcessorConfig{
+		DecisionWait: decisionWait,
+		Policies:     policies,
+	}
+}
+
+// shouldDeriveTraceMetrics returns true if the pipeline derives metrics from its spans and the target MetricPipeline is part of the configuration.
+// Without the MetricPipeline, the connectors would have no consuming service pipeline, which the collector rejects.
+func shouldDeriveTraceMetrics(tp *telemetryv1beta1.TracePipeline, mps []telemetryv1beta1.MetricPipeline) bool {
+	if tp.Spec.Metrics == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(mps, func(mp telemetryv1beta1.MetricPipeline) bool {
+		return mp.Name == tp.Spec.Metrics.MetricPipeline
+	})
+}
+
+func spanMetricsConnectorConfig(spanMetrics *telemetryv1beta1.SpanMetrics) *common.SpanMetricsConnectorConfig {
+	dimensions := make([]common.SpanMetricsDimension, 0, len(spanMetrics.Dimensions))
+	for _, name := range spanMetrics.Dimensions {
+		dimensions = append(dimensions, common.SpanMetricsDimension{Name: name})
+	}
+
+	return &common.SpanMetricsConnectorConfig{Dimensions: dimensions}
+}
+
+func serviceGraphConnectorConfig(serviceGraph *telemetryv1beta1.ServiceGraphMetrics) *common.ServiceGraphConnectorConfig {
+	return &common.ServiceGraphConnectorConfig{Dimensions: serviceGraph.Dimensions}
+}
+
+// countTraceOutputs returns the number of outputs of all pipelines, which share the available queue size.
+func countTraceOutputs(tps []telemetryv1beta1.TracePipeline) int {
+	count := 0
+	for i := range tps {
+		count += len(pipelines.TracePipelineOutputs(&tps[i])) + len(pipelines.TracePipelineKafkaOutputs(&tps[i]))
+	}
+
+	return count
 }
 
 func formatTraceServicePipelineID(tp *telemetryv1beta1.TracePipeline) string {
 	return fmt.Sprintf("traces/%s", tp.Name)
 }
 
+func formatTraceSamplingServicePipelineID(tp *telemetryv1beta1.TracePipeline) string {
+	return fmt.Sprintf("traces/%s-sampling", tp.Name)
+}
+
+func formatTraceRedactionProcessorID(tp *telemetryv1beta1.TracePipeline) string {
+	return common.ComponentIDRedactionProcessor(pipelines.TracePipelineRef(tp))
+}
+
 func formatTraceUserDefinedTransformProcessorID(tp *telemetryv1beta1.TracePipeline) string {
 	return common.ComponentIDUserDefinedTransformProcessor(pipelines.TracePipelineRef(tp))
 }
@@ -257,6 +571,22 @@ func formatTraceUserDefinedFilterProcessorID(tp *telemetryv1beta1.TracePipeline)
 	return common.ComponentIDUserDefinedFilterProcessor(pipelines.TracePipelineRef(tp))
 }
 
-func formatTraceOTLPExporterID(tp *telemetryv1beta1.TracePipeline) string {
-	return common.ComponentIDOTLPExporter(tp.Spec.Output.OTLP.Protocol, pipelines.TracePipelineRef(tp))
+func formatTraceLoadBalancingExporterID(tp *telemetryv1beta1.TracePipeline) string {
+	return common.ComponentIDLoadBalancingExporter(pipelines.TracePipelineRef(tp))
+}
+
+func formatTraceSpanMetricsConnectorID(tp *telemetryv1beta1.TracePipeline) string {
+	return common.ComponentIDSpanMetricsConnector(pipelines.TracePipelineRef(tp))
+}
+
+func formatTraceServiceGraphConnectorID(tp *telemetryv1beta1.TracePipeline) string {
+	return common.ComponentIDServiceGraphConnector(pipelines.TracePipelineRef(tp))
+}
+
+func formatTraceTailSamplingProcessorID(tp *telemetryv1beta1.TracePipeline) string {
+	return common.ComponentIDTailSamplingProcessor(pipelines.TracePipelineRef(tp))
+}
+
+func formatTraceHeadSamplingProcessorID(tp *telemetryv1beta1.TracePipeline) string {
+	return common.ComponentIDHeadSamplingProcessor(pipelines.TracePipelineRef(tp))
 }
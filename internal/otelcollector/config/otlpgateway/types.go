@@ -1,5 +1,9 @@
 package otlpgateway
 
+import (
+	"github.com/kyma-project/telemetry-manager/internal/otelcollector/config/common"
+)
+
 // IstioEnrichmentProcessorConfig enriches Istio access logs with module version.
 type IstioEnrichmentProcessorConfig struct {
 	ScopeVersion string `yaml:"scope_version,omitempty"`
@@ -13,9 +17,69 @@ type KymaStatsReceiverConfig struct {
 	K8sLeaderElector   string      `yaml:"k8s_leader_elector"`
 }
 
+// K8sEventsReceiverConfig configures the k8s_events receiver for collecting Kubernetes events as logs.
+type K8sEventsReceiverConfig struct {
+	AuthType         string `yaml:"auth_type"`
+	K8sLeaderElector string `yaml:"k8s_leader_elector"`
+}
+
 // ModuleGVR represents a Kubernetes Group/Version/Resource for the kymastats receiver.
 type ModuleGVR struct {
 	Group    string `yaml:"group"`
 	Version  string `yaml:"version"`
 	Resource string `yaml:"resource"`
 }
+
+// TailSamplingProcessorConfig configures the tail_sampling processor, which decides whether to keep a trace after all its spans have been received.
+type TailSamplingProcessorConfig struct {
+	DecisionWait string               `yaml:"decision_wait"`
+	Policies     []TailSamplingPolicy `yaml:"policies"`
+}
+
+// TailSamplingPolicy represents a single policy of the tail_sampling processor. Only the field matching Type is set.
+type TailSamplingPolicy struct {
+	Name            string                             `yaml:"name"`
+	Type            string                             `yaml:"type"`
+	Probabilistic   *TailSamplingProbabilisticConfig   `yaml:"probabilistic,omitempty"`
+	Latency         *TailSamplingLatencyConfig         `yaml:"latency,omitempty"`
+	StatusCode      *TailSamplingStatusCodeConfig      `yaml:"status_code,omitempty"`
+	StringAttribute *TailSamplingStringAttributeConfig `yaml:"string_attribute,omitempty"`
+}
+
+type TailSamplingProbabilisticConfig struct {
+	SamplingPercentage int32 `yaml:"sampling_percentage"`
+}
+
+type TailSamplingLatencyConfig struct {
+	ThresholdMs int64 `yaml:"threshold_ms"`
+}
+
+type TailSamplingStatusCodeConfig struct {
+	StatusCodes []string `yaml:"status_codes"`
+}
+
+type TailSamplingStringAttributeConfig struct {
+	Key                  string   `yaml:"key"`
+	Values               []string `yaml:"values"`
+	EnabledRegexMatching bool     `yaml:"enabled_regex_matching,omitempty"`
+}
+
+// LoadBalancingExporterConfig configures the loadbalancing exporter, which routes spans of the same trace to the same gateway instance.
+type LoadBalancingExporterConfig struct {
+	RoutingKey string                `yaml:"routing_key"`
+	Protocol   LoadBalancingProtocol `yaml:"protocol"`
+	Resolver   LoadBalancingResolver `yaml:"resolver"`
+}
+
+type LoadBalancingProtocol struct {
+	OTLP common.OTLPExporterConfig `yaml:"otlp"`
+}
+
+type LoadBalancingResolver struct {
+	K8s LoadBalancingK8sResolver `yaml:"k8s"`
+}
+
+type LoadBalancingK8sResolver struct {
+	Service string  `yaml:"service"`
+	Ports   []int32 `yaml:"ports"`
+}
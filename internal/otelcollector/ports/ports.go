@@ -1,10 +1,12 @@
 package ports
 
 const (
-	OTLPHTTP            int32 = 4318
-	OTLPGRPC            int32 = 4317
-	Metrics             int32 = 8888
-	HealthCheck         int32 = 13133
-	Pprof               int32 = 1777
-	IstioEnvoyTelemetry int32 = 15090
+	OTLPHTTP             int32 = 4318
+	OTLPGRPC             int32 = 4317
+	OTLPTraceSampling    int32 = 4319
+	PrometheusExposition int32 = 8889
+	Metrics              int32 = 8888
+	HealthCheck          int32 = 13133
+	Pprof                int32 = 1777
+	IstioEnvoyTelemetry  int32 = 15090
 )
@@ -0,0 +1,204 @@
+package pipelines
+
+import telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+
+// FailoverOutputName is the name under which the failover output of a pipeline is exported and monitored.
+// It is reserved and cannot be used for additional outputs.
+const FailoverOutputName = "failover"
+
+// OTLPOutput is an OTLP output of a pipeline, together with the reference that identifies the components exporting to it.
+type OTLPOutput struct {
+	Ref  PipelineRef
+	OTLP *telemetryv1beta1.OTLPOutput
+}
+
+// Name returns the name of the output, or an empty string for the primary output.
+func (o OTLPOutput) Name() string {
+	return o.Ref.OutputName()
+}
+
+// IsFailover returns true if the output is the failover output of the primary output.
+func (o OTLPOutput) IsFailover() bool {
+	return o.Name() == FailoverOutputName
+}
+
+// TracePipelineOutputs returns the primary output of the pipeline, its failover output, and its additional outputs.
+func TracePipelineOutputs(tp *telemetryv1beta1.TracePipeline) []OTLPOutput {
+	return outputs(TracePipelineRef(tp), tp.Spec.Output.OTLP, tp.Spec.Output.Failover, tp.Spec.AdditionalOutputs)
+}
+
+// MetricPipelineOutputs returns the primary output of the pipeline, its failover output, and its additional outputs.
+func MetricPipelineOutputs(mp *telemetryv1beta1.MetricPipeline) []OTLPOutput {
+	var primary *telemetryv1beta1.OTLPOutput
+	if mp.Spec.Output.OTLP != nil {
+		primary = &mp.Spec.Output.OTLP.OTLPOutput
+	}
+
+	return outputs(MetricPipelineRef(mp), primary, mp.Spec.Output.Failover, mp.Spec.AdditionalOutputs)
+}
+
+// LogPipelineOutputs returns the primary OTLP output of the pipeline, its failover output, and its additional outputs.
+// Returns nil for pipelines with a Fluent Bit output.
+// A Loki output is returned as its OTLP/HTTP view, because Loki natively ingests OTLP.
+func LogPipelineOutputs(lp *telemetryv1beta1.LogPipeline) []OTLPOutput {
+	if lp.Spec.Output.Loki != nil {
+		return []OTLPOutput{LokiOutput{Ref: LogPipelineRef(lp), Loki: lp.Spec.Output.Loki}.OTLPOutput()}
+	}
+
+	if lp.Spec.Output.OTLP == nil {
+		return nil
+	}
+
+	return outputs(LogPipelineRef(lp), lp.Spec.Output.OTLP, lp.Spec.Output.Failover, lp.Spec.AdditionalOutputs)
+}
+
+// KafkaOutput is a Kafka output of a pipeline, together with the reference that identifies the components exporting to it.
+type KafkaOutput struct {
+	Ref   PipelineRef
+	Kafka *telemetryv1beta1.KafkaOutput
+}
+
+// TracePipelineKafkaOutputs returns the Kafka output of the pipeline, if any.
+func TracePipelineKafkaOutputs(tp *telemetryv1beta1.TracePipeline) []KafkaOutput {
+	return kafkaOutputs(TracePipelineRef(tp), tp.Spec.Output.Kafka)
+}
+
+// MetricPipelineKafkaOutputs returns the Kafka output of the pipeline, if any.
+func MetricPipelineKafkaOutputs(mp *telemetryv1beta1.MetricPipeline) []KafkaOutput {
+	return kafkaOutputs(MetricPipelineRef(mp), mp.Spec.Output.Kafka)
+}
+
+// LogPipelineKafkaOutputs returns the Kafka output of the pipeline, if any.
+func LogPipelineKafkaOutputs(lp *telemetryv1beta1.LogPipeline) []KafkaOutput {
+	return kafkaOutputs(LogPipelineRef(lp), lp.Spec.Output.Kafka)
+}
+
+// PrometheusRemoteWriteOutput is a Prometheus remote-write output of a metric pipeline, together with the reference that identifies the components exporting to it.
+type PrometheusRemoteWriteOutput struct {
+	Ref                   PipelineRef
+	PrometheusRemoteWrite *telemetryv1beta1.PrometheusRemoteWriteOutput
+}
+
+// OTLPOutput returns the connection settings of the remote-write output as an OTLP/HTTP output.
+// Remote-write and OTLP/HTTP outputs share endpoint, header, authentication, and TLS options, so they are resolved and validated the same way.
+func (o PrometheusRemoteWriteOutput) OTLPOutput() OTLPOutput {
+	return OTLPOutput{
+		Ref: o.Ref,
+		OTLP: &telemetryv1beta1.OTLPOutput{
+			Protocol:       telemetryv1beta1.OTLPProtocolHTTP,
+			Endpoint:       o.PrometheusRemoteWrite.Endpoint,
+			Authentication: o.PrometheusRemoteWrite.Authentication,
+			Headers:        o.PrometheusRemoteWrite.Headers,
+			TLS:            o.PrometheusRemoteWrite.TLS,
+		},
+	}
+}
+
+// MetricPipelinePrometheusRemoteWriteOutputs returns the Prometheus remote-write output of the pipeline, if any.
+func MetricPipelinePrometheusRemoteWriteOutputs(mp *telemetryv1beta1.MetricPipeline) []PrometheusRemoteWriteOutput {
+	if mp.Spec.Output.PrometheusRemoteWrite == nil {
+		return nil
+	}
+
+	return []PrometheusRemoteWriteOutput{{Ref: MetricPipelineRef(mp), PrometheusRemoteWrite: mp.Spec.Output.PrometheusRemoteWrite}}
+}
+
+// lokiOTLPPath is the path of the native OTLP logs endpoint of Loki
+const lokiOTLPPath = "/otlp/v1/logs"
+
+// LokiOutput is a Loki output of a log pipeline, together with the reference that identifies the components exporting to it.
+type LokiOutput struct {
+	Ref  PipelineRef
+	Loki *telemetryv1beta1.LokiOutput
+}
+
+// OTLPOutput returns the Loki output as an OTLP/HTTP output that pushes logs to the native OTLP endpoint of Loki.
+func (o LokiOutput) OTLPOutput() OTLPOutput {
+	return OTLPOutput{
+		Ref: o.Ref,
+		OTLP: &telemetryv1beta1.OTLPOutput{
+			Protocol:       telemetryv1beta1.OTLPProtocolHTTP,
+			Endpoint:       o.Loki.Endpoint,
+			Path:           lokiOTLPPath,
+			Authentication: o.Loki.Authentication,
+			Headers:        o.Loki.Headers,
+			TLS:            o.Loki.TLS,
+		},
+	}
+}
+
+// ElasticsearchOutput is an Elasticsearch output of a log pipeline, together with the reference that identifies the components exporting to it.
+type ElasticsearchOutput struct {
+	Ref           PipelineRef
+	Elasticsearch *telemetryv1beta1.ElasticsearchOutput
+}
+
+// OTLPOutput returns the connection settings of the Elasticsearch output as an OTLP/HTTP output.
+// Elasticsearch and OTLP/HTTP outputs share endpoint, header, authentication, and TLS options, so they are resolved and validated the same way.
+func (o ElasticsearchOutput) OTLPOutput() OTLPOutput {
+	return OTLPOutput{
+		Ref: o.Ref,
+		OTLP: &telemetryv1beta1.OTLPOutput{
+			Protocol:       telemetryv1beta1.OTLPProtocolHTTP,
+			Endpoint:       o.Elasticsearch.Endpoint,
+			Authentication: o.Elasticsearch.Authentication,
+			Headers:        o.Elasticsearch.Headers,
+			TLS:            o.Elasticsearch.TLS,
+		},
+	}
+}
+
+// LogPipelineElasticsearchOutputs returns the Elasticsearch output of the pipeline, if any.
+func LogPipelineElasticsearchOutputs(lp *telemetryv1beta1.LogPipeline) []ElasticsearchOutput {
+	if lp.Spec.Output.Elasticsearch == nil {
+		return nil
+	}
+
+	return []ElasticsearchOutput{{Ref: LogPipelineRef(lp), Elasticsearch: lp.Spec.Output.Elasticsearch}}
+}
+
+// PersistentQueueEnabled returns true if the given output buffers data in a persistent sending queue.
+func PersistentQueueEnabled(otlp *telemetryv1beta1.OTLPOutput) bool {
+	return otlp != nil && otlp.PersistentQueue != nil && otlp.PersistentQueue.Enabled
+}
+
+// FailoverOutput returns the failover output from the given outputs, or nil if there is none.
+func FailoverOutput(outputs []OTLPOutput) *OTLPOutput {
+	for i := range outputs {
+		if outputs[i].IsFailover() {
+			return &outputs[i]
+		}
+	}
+
+	return nil
+}
+
+func outputs(ref PipelineRef, primary, failover *telemetryv1beta1.OTLPOutput, additional []telemetryv1beta1.NamedOTLPOutput) []OTLPOutput {
+	outputs := make([]OTLPOutput, 0, len(additional)+2)
+
+	if primary != nil {
+		outputs = append(outputs, OTLPOutput{Ref: ref, OTLP: primary})
+	}
+
+	if primary != nil && failover != nil {
+		outputs = append(outputs, OTLPOutput{Ref: ref.WithOutput(FailoverOutputName), OTLP: failover})
+	}
+
+	for _, output := range additional {
+		if output.OTLP == nil {
+			continue
+		}
+
+		outputs = append(outputs, OTLPOutput{Ref: ref.WithOutput(output.Name), OTLP: output.OTLP})
+	}
+
+	return outputs
+}
+
+func kafkaOutputs(ref PipelineRef, kafka *telemetryv1beta1.KafkaOutput) []KafkaOutput {
+	if kafka == nil {
+		return nil
+	}
+
+	return []KafkaOutput{{Ref: ref, Kafka: kafka}}
+}
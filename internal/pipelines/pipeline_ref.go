@@ -6,6 +6,7 @@ import telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetr
 type PipelineRef struct {
 	name       string
 	signalType SignalType
+	outputName string
 }
 
 func LogPipelineRef(lp *telemetryv1beta1.LogPipeline) PipelineRef {
@@ -28,6 +29,18 @@ func (r PipelineRef) SignalType() SignalType {
 	return r.signalType
 }
 
+// WithOutput returns a reference to the given additional output of the pipeline.
+// Components of an additional output are identified by the pipeline name suffixed with the output name.
+func (r PipelineRef) WithOutput(outputName string) PipelineRef {
+	r.outputName = outputName
+	return r
+}
+
+// OutputName returns the name of the referenced additional output, or an empty string if the primary output is referenced.
+func (r PipelineRef) OutputName() string {
+	return r.outputName
+}
+
 // TypePrefix returns "<signalType>pipeline".
 // Example: signalType="trace" → "tracepipeline"
 func (r PipelineRef) TypePrefix() string {
@@ -38,13 +51,20 @@ func (r PipelineRef) TypePrefix() string {
 	return string(r.signalType) + "pipeline"
 }
 
-// QualifiedName returns the pipeline name, prefixed with the signal type.
+// QualifiedName returns the pipeline name, prefixed with the signal type and suffixed with the output name, if any.
 // Example: signalType="trace", name="my-pipeline" → "tracepipeline-my-pipeline"
+// Example: signalType="trace", name="my-pipeline", outputName="archive" → "tracepipeline-my-pipeline_archive"
 func (r PipelineRef) QualifiedName() string {
+	name := r.name
+	if r.outputName != "" {
+		// Kubernetes resource names cannot contain underscores, so the suffix cannot clash with another pipeline name
+		name += "_" + r.outputName
+	}
+
 	prefix := r.TypePrefix()
 	if prefix == "" {
-		return r.name
+		return name
 	}
 
-	return prefix + "-" + r.name
+	return prefix + "-" + name
 }
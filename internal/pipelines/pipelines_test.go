@@ -49,3 +49,13 @@ func TestPipelineRefEmptySignalType(t *testing.T) {
 	require.Equal(t, "", ref.TypePrefix())
 	require.Equal(t, "orphan", ref.QualifiedName())
 }
+
+func TestPipelineRefWithOutput(t *testing.T) {
+	tp := &telemetryv1beta1.TracePipeline{ObjectMeta: metav1.ObjectMeta{Name: "my-trace"}}
+	ref := TracePipelineRef(tp).WithOutput("archive")
+	require.Equal(t, "my-trace", ref.Name())
+	require.Equal(t, "archive", ref.OutputName())
+	require.Equal(t, "tracepipeline", ref.TypePrefix())
+	require.Equal(t, "tracepipeline-my-trace_archive", ref.QualifiedName())
+	require.Equal(t, "", TracePipelineRef(tp).OutputName())
+}
@@ -0,0 +1,56 @@
+package commonstatus
+
+import (
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/telemetry-manager/internal/conditions"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+// SetOutputFlowHealthConditions sets a TelemetryFlowHealthy sub-condition for every additional output of a pipeline
+// and removes the sub-conditions of outputs that are no longer part of the pipeline.
+// The failover output has no sub-condition, its state is reflected by the pipeline-level flow health condition.
+// Outputs without an entry in outputReasons inherit the status and reason of the pipeline-level flow health condition.
+func SetOutputFlowHealthConditions(conds *[]metav1.Condition, pipelineCondition metav1.Condition, outputs []pipelines.OTLPOutput, outputReasons map[string]string, messageFunc func(reason string) string) {
+	var outputNames []string
+
+	for _, output := range outputs {
+		if output.Name() == "" || output.IsFailover() {
+			continue
+		}
+
+		outputNames = append(outputNames, output.Name())
+
+		condition := pipelineCondition
+		condition.Type = conditions.TypeFlowHealthyForOutput(output.Name())
+
+		if reason, ok := outputReasons[output.Name()]; ok {
+			condition.Status = metav1.ConditionFalse
+			if reason == conditions.ReasonSelfMonFlowHealthy {
+				condition.Status = metav1.ConditionTrue
+			}
+
+			condition.Reason = reason
+			condition.Message = messageFunc(reason)
+		}
+
+		meta.SetStatusCondition(conds, condition)
+	}
+
+	var staleTypes []string
+
+	for _, condition := range *conds {
+		outputName, isOutputCondition := strings.CutPrefix(condition.Type, conditions.TypeFlowHealthyForOutput(""))
+		if isOutputCondition && !slices.Contains(outputNames, outputName) {
+			staleTypes = append(staleTypes, condition.Type)
+		}
+	}
+
+	for _, conditionType := range staleTypes {
+		meta.RemoveStatusCondition(conds, conditionType)
+	}
+}
@@ -0,0 +1,49 @@
+package fluentbit
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/fluentbitmigration"
+)
+
+// updateMigrationResult converts the pipeline into an OpenTelemetry-based LogPipeline if requested by the migration annotation,
+// and writes the result to the migration result annotation. The result annotation is removed once the migration annotation is removed.
+func (r *Reconciler) updateMigrationResult(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error {
+	var result string
+
+	if pipeline.Annotations[fluentbitmigration.AnnotationKeyMigration] == fluentbitmigration.AnnotationValueMigrationDryRun {
+		report, err := fluentbitmigration.Convert(pipeline).Report()
+		if err != nil {
+			return err
+		}
+
+		result = report
+	}
+
+	current, exists := pipeline.Annotations[fluentbitmigration.AnnotationKeyMigrationResult]
+	if current == result && exists == (result != "") {
+		return nil
+	}
+
+	patch := client.MergeFrom(pipeline.DeepCopy())
+
+	if result == "" {
+		delete(pipeline.Annotations, fluentbitmigration.AnnotationKeyMigrationResult)
+	} else {
+		if pipeline.Annotations == nil {
+			pipeline.Annotations = make(map[string]string)
+		}
+
+		pipeline.Annotations[fluentbitmigration.AnnotationKeyMigrationResult] = result
+	}
+
+	if err := r.Patch(ctx, pipeline, patch); err != nil {
+		return fmt.Errorf("failed to patch migration result annotation: %w", err)
+	}
+
+	return nil
+}
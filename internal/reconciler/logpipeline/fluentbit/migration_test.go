@@ -0,0 +1,59 @@
+package fluentbit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/telemetry-manager/internal/fluentbitmigration"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestMigrationResult(t *testing.T) {
+	t.Run("dry-run writes the result annotation", func(t *testing.T) {
+		pipeline := testutils.NewLogPipelineBuilder().
+			WithHTTPOutput(testutils.HTTPHost("logs.example.com")).
+			WithDropLabels(true).
+			WithCustomFilter("Name grep\nExclude path /healthz").
+			Build()
+		pipeline.Annotations = map[string]string{
+			fluentbitmigration.AnnotationKeyMigration: fluentbitmigration.AnnotationValueMigrationDryRun,
+		}
+		testClient := newTestClient(t, &pipeline)
+		reconciler := newTestReconciler(testClient)
+
+		result := reconcileAndGet(t, testClient, reconciler, pipeline.Name)
+		require.NoError(t, result.err)
+
+		expected, err := fluentbitmigration.Convert(&pipeline).Report()
+		require.NoError(t, err)
+		require.Equal(t, expected, result.pipeline.Annotations[fluentbitmigration.AnnotationKeyMigrationResult])
+		require.Contains(t, expected, `IsMatch(log.attributes[\"path\"], \"/healthz\")`)
+	})
+
+	t.Run("result annotation is removed without migration annotation", func(t *testing.T) {
+		pipeline := testutils.NewLogPipelineBuilder().WithHTTPOutput().Build()
+		pipeline.Annotations = map[string]string{
+			fluentbitmigration.AnnotationKeyMigrationResult: "{}",
+		}
+		testClient := newTestClient(t, &pipeline)
+		reconciler := newTestReconciler(testClient)
+
+		result := reconcileAndGet(t, testClient, reconciler, pipeline.Name)
+		require.NoError(t, result.err)
+		require.NotContains(t, result.pipeline.Annotations, fluentbitmigration.AnnotationKeyMigrationResult)
+	})
+
+	t.Run("unknown migration mode is ignored", func(t *testing.T) {
+		pipeline := testutils.NewLogPipelineBuilder().WithHTTPOutput().Build()
+		pipeline.Annotations = map[string]string{
+			fluentbitmigration.AnnotationKeyMigration: "apply",
+		}
+		testClient := newTestClient(t, &pipeline)
+		reconciler := newTestReconciler(testClient)
+
+		result := reconcileAndGet(t, testClient, reconciler, pipeline.Name)
+		require.NoError(t, result.err)
+		require.NotContains(t, result.pipeline.Annotations, fluentbitmigration.AnnotationKeyMigrationResult)
+	})
+}
@@ -139,6 +139,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, pipeline *telemetryv1beta1.L
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to reconcile: %w", err))
 	}
 
+	if err := r.updateMigrationResult(ctx, pipeline); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update migration result: %w", err))
+	}
+
 	if err := r.updateStatus(ctx, pipeline.Name); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update status: %w", err))
 	}
@@ -143,3 +143,19 @@ type FilterSpecValidator interface {
 	// Returns an error if any filter is invalid or unsupported.
 	Validate(filters []telemetryv1beta1.FilterSpec) error
 }
+
+// MultilineValidator validates the multiline rules of the runtime input in log pipeline configurations.
+// It ensures that the custom patterns are valid regular expressions.
+type MultilineValidator interface {
+	// Validate checks if the custom patterns of all multiline rules compile.
+	// Returns an error if any pattern is invalid.
+	Validate(pipeline *telemetryv1beta1.LogPipeline) error
+}
+
+// RedactionValidator validates the redaction of sensitive data in log pipeline configurations.
+// It ensures that the custom patterns are valid regular expressions.
+type RedactionValidator interface {
+	// Validate checks if all custom redaction patterns compile.
+	// Returns an error if any pattern is invalid.
+	Validate(redaction *telemetryv1beta1.RedactionSpec) error
+}
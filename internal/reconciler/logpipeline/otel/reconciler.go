@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -377,12 +378,13 @@ func (r *Reconciler) reconcileAgent(ctx context.Context, pipeline *telemetryv1be
 		ctx,
 		k8sclients.NewOwnerReferenceSetter(r.Client, pipeline),
 		otelcollector.AgentApplyOptions{
-			IstioEnabled:        isIstioActive,
-			VpaCRDExists:        vpaCRDExists,
-			VpaEnabled:          isVpaEnabled,
-			VPAMaxAllowedMemory: vpaMaxAllowedMemory,
-			CollectorConfigYAML: string(agentConfigYAML),
-			CollectorEnvVars:    envVars,
+			IstioEnabled:           isIstioActive,
+			VpaCRDExists:           vpaCRDExists,
+			VpaEnabled:             isVpaEnabled,
+			VPAMaxAllowedMemory:    vpaMaxAllowedMemory,
+			CollectorConfigYAML:    string(agentConfigYAML),
+			CollectorEnvVars:       envVars,
+			PersistentQueueEnabled: common.HasSendingQueueStorage(agentConfig),
 		},
 	); err != nil {
 		return fmt.Errorf("failed to apply agent resources: %w", err)
@@ -416,7 +418,7 @@ func (r *Reconciler) trackPipelineInfoMetric(ctx context.Context, pipelines []te
 		var features []string
 
 		// General features
-		if sharedtypesutils.IsOTLPInputEnabled(pipeline.Spec.Input.OTLP) {
+		if logpipelineutils.IsOTLPInputEnabled(&pipeline.Spec.Input) {
 			features = append(features, metrics.FeatureInputOTLP)
 		}
 
@@ -441,11 +443,22 @@ func (r *Reconciler) trackPipelineInfoMetric(ctx context.Context, pipelines []te
 }
 
 func (r *Reconciler) getEndpoint(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) string {
-	if pipeline.Spec.Output.OTLP == nil {
+	var endpoint telemetryv1beta1.ValueType
+
+	switch {
+	case pipeline.Spec.Output.Kafka != nil:
+		return strings.Join(pipeline.Spec.Output.Kafka.Brokers, ",")
+	case pipeline.Spec.Output.Loki != nil:
+		endpoint = pipeline.Spec.Output.Loki.Endpoint
+	case pipeline.Spec.Output.Elasticsearch != nil:
+		endpoint = pipeline.Spec.Output.Elasticsearch.Endpoint
+	case pipeline.Spec.Output.OTLP != nil:
+		endpoint = pipeline.Spec.Output.OTLP.Endpoint
+	default:
 		return ""
 	}
 
-	endpointBytes, err := sharedtypesutils.ResolveValue(ctx, r.Client, pipeline.Spec.Output.OTLP.Endpoint)
+	endpointBytes, err := sharedtypesutils.ResolveValue(ctx, r.Client, endpoint)
 	if err != nil {
 		return ""
 	}
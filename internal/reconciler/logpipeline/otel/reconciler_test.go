@@ -22,7 +22,9 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/stubs"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+	"github.com/kyma-project/telemetry-manager/internal/validators/multiline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/redaction"
 	"github.com/kyma-project/telemetry-manager/internal/workloadstatus"
 )
 
@@ -184,6 +186,15 @@ func TestGatewayFlowHealthCondition(t *testing.T) {
 			expectedReason:  conditions.ReasonSelfMonGatewayThrottling,
 			expectedMessage: "OTLP Gateway is unable to receive logs at current rate. See troubleshooting: " + conditions.LinkGatewayThrottling,
 		},
+		{
+			name: "buffer filling up",
+			probe: prober.OTelGatewayProbeResult{
+				BufferFillingUp: true,
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonGatewayBufferFillingUp,
+			expectedMessage: "Buffer nearing capacity. Incoming log rate exceeds export rate in OTLP Gateway. See troubleshooting: " + conditions.LinkBufferFillingUp,
+		},
 		{
 			name: "some data dropped",
 			probe: prober.OTelGatewayProbeResult{
@@ -252,6 +263,64 @@ func TestGatewayFlowHealthCondition(t *testing.T) {
 	}
 }
 
+func TestFlowHealthProbingByInput(t *testing.T) {
+	allDataDroppedInAgent := prober.OTelAgentProbeResult{
+		PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true},
+	}
+	allDataDroppedInGateway := prober.OTelGatewayProbeResult{
+		PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true},
+	}
+
+	tests := []struct {
+		name                 string
+		pipeline             telemetryv1beta1.LogPipeline
+		expectAgentProbing   bool
+		expectGatewayProbing bool
+		expectedReason       string
+	}{
+		{
+			name:                 "events input only",
+			pipeline:             testutils.NewLogPipelineBuilder().WithName("pipeline").WithOTLPOutput(testutils.OTLPEndpoint("http://localhost")).WithEventsInput(true).Build(),
+			expectGatewayProbing: true,
+			expectedReason:       conditions.ReasonSelfMonGatewayAllDataDropped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := newTestClient(t, &tt.pipeline)
+
+			agentFlowHealthProber := &mocks.AgentFlowHealthProber{}
+			agentFlowHealthProber.On("Probe", mock.Anything, tt.pipeline.Name).Return(allDataDroppedInAgent, nil)
+
+			gatewayFlowHealthProber := &mocks.GatewayFlowHealthProber{}
+			gatewayFlowHealthProber.On("Probe", mock.Anything, tt.pipeline.Name).Return(allDataDroppedInGateway, nil)
+
+			sut := newTestReconciler(fakeClient,
+				WithAgentFlowHealthProber(agentFlowHealthProber),
+				WithGatewayFlowHealthProber(gatewayFlowHealthProber))
+			result := reconcileAndGet(t, fakeClient, sut, tt.pipeline.Name)
+			require.NoError(t, result.err)
+
+			if tt.expectAgentProbing {
+				agentFlowHealthProber.AssertCalled(t, "Probe", mock.Anything, tt.pipeline.Name)
+			} else {
+				agentFlowHealthProber.AssertNotCalled(t, "Probe", mock.Anything, tt.pipeline.Name)
+			}
+
+			if tt.expectGatewayProbing {
+				gatewayFlowHealthProber.AssertCalled(t, "Probe", mock.Anything, tt.pipeline.Name)
+			} else {
+				gatewayFlowHealthProber.AssertNotCalled(t, "Probe", mock.Anything, tt.pipeline.Name)
+			}
+
+			cond := meta.FindStatusCondition(result.pipeline.Status.Conditions, conditions.TypeFlowHealthy)
+			require.NotNil(t, cond)
+			require.Equal(t, metav1.ConditionFalse, cond.Status)
+			require.Equal(t, tt.expectedReason, cond.Reason)
+		})
+	}
+}
+
 func TestOTTLSpecValidation(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -284,6 +353,30 @@ func TestOTTLSpecValidation(t *testing.T) {
 			condReason:  conditions.ReasonOTTLSpecInvalid,
 			condMessage: "OTTL specification is invalid, invalid FilterSpec: error while parsing conditions. Fix the syntax error indicated by the message or see troubleshooting: " + conditions.LinkOTTLSpecInvalid,
 		},
+		{
+			name: "invalid multiline pattern",
+			validator: newTestValidator(
+				WithMultilineValidator(stubs.NewMultilineValidator(
+					&multiline.InvalidMultilinePatternError{
+						Err: fmt.Errorf("invalid multiline pattern \"^(\": missing closing )"),
+					},
+				))),
+			condStatus:  metav1.ConditionFalse,
+			condReason:  conditions.ReasonMultilinePatternInvalid,
+			condMessage: "Invalid multiline pattern \"^(\": missing closing )",
+		},
+		{
+			name: "invalid redaction pattern",
+			validator: newTestValidator(
+				WithRedactionValidator(stubs.NewRedactionValidator(
+					&redaction.InvalidRedactionPatternError{
+						Err: fmt.Errorf("invalid redaction pattern \"[0-9\": missing closing ]"),
+					},
+				))),
+			condStatus:  metav1.ConditionFalse,
+			condReason:  conditions.ReasonRedactionPatternInvalid,
+			condMessage: "Invalid redaction pattern \"[0-9\": missing closing ]",
+		},
 	}
 
 	for _, tt := range tests {
@@ -19,8 +19,11 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	logpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/logpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
+	"github.com/kyma-project/telemetry-manager/internal/validators/multiline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/redaction"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
 
@@ -81,7 +84,7 @@ func (r *Reconciler) setGatewayHealthyCondition(ctx context.Context, pipeline *t
 }
 
 func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) error {
-	status, reason, err := r.evaluateFlowHealthCondition(ctx, pipeline)
+	status, reason, outputReasons, err := r.evaluateFlowHealthCondition(ctx, pipeline)
 
 	condition := metav1.Condition{
 		Type:               conditions.TypeFlowHealthy,
@@ -92,54 +95,88 @@ func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telem
 	}
 
 	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+	commonstatus.SetOutputFlowHealthConditions(&pipeline.Status.Conditions, condition, pipelines.LogPipelineOutputs(pipeline), outputReasons, conditions.MessageForOtelLogPipeline)
 
 	return err
 }
 
-func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) (metav1.ConditionStatus, string, error) {
+// evaluateFlowHealthCondition returns the status and reason of the pipeline-level flow health condition,
+// together with the flow health reasons of the additional outputs keyed by output name.
+func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) (metav1.ConditionStatus, string, map[string]string, error) {
 	configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
 	if configGeneratedStatus == metav1.ConditionFalse {
-		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, nil
+		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, nil, nil
 	}
 
 	// Probe agent flow health (if pipeline uses runtime input)
 	var agentReason string
 
+	agentOutputReasons := make(map[string]string)
+
 	if requiresAgentProbing(pipeline) && r.agentFlowHealthProber != nil {
 		agentProbeResult, err := r.agentFlowHealthProber.Probe(ctx, pipeline.Name)
 		if err != nil {
-			return metav1.ConditionUnknown, conditions.ReasonSelfMonAgentProbingFailed, fmt.Errorf("failed to probe agent flow health: %w", err)
+			return metav1.ConditionUnknown, conditions.ReasonSelfMonAgentProbingFailed, nil, fmt.Errorf("failed to probe agent flow health: %w", err)
 		}
 
 		logf.FromContext(ctx).V(1).Info("Probed agent flow health", "result", agentProbeResult)
 		agentReason = agentFlowHealthReasonFor(agentProbeResult)
+
+		for _, output := range pipeline.Spec.AdditionalOutputs {
+			agentOutputReasons[output.Name] = agentFlowHealthReasonFor(prober.OTelAgentProbeResult{PipelineProbeResult: agentProbeResult.Outputs.Get(output.Name)})
+		}
 	}
 
-	// Probe gateway flow health (if pipeline uses OTLP input)
-	var gatewayReason string
+	// Probe gateway flow health (if pipeline uses OTLP input or events input)
+	var (
+		gatewayReason  string
+		failoverActive bool
+	)
+
+	gatewayOutputReasons := make(map[string]string)
 
 	if requiresGatewayProbing(pipeline) && r.gatewayFlowHealthProber != nil {
 		gatewayProbeResult, err := r.gatewayFlowHealthProber.Probe(ctx, pipeline.Name)
 		if err != nil {
-			return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, fmt.Errorf("failed to probe gateway flow health: %w", err)
+			return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, nil, fmt.Errorf("failed to probe gateway flow health: %w", err)
 		}
 
 		logf.FromContext(ctx).V(1).Info("Probed gateway flow health", "result", gatewayProbeResult)
+
+		// While the failover output is active, the data drops of the primary output are expected
+		failoverActive = pipeline.Spec.Output.Failover != nil && gatewayProbeResult.OnlyPrimaryOutputDropping()
+		if failoverActive {
+			gatewayProbeResult.PipelineProbeResult = prober.PipelineProbeResult{Healthy: true}
+		}
+
 		gatewayReason = gatewayFlowHealthReasonFor(gatewayProbeResult)
+
+		for _, output := range pipeline.Spec.AdditionalOutputs {
+			gatewayOutputReasons[output.Name] = gatewayFlowHealthReasonFor(prober.OTelGatewayProbeResult{PipelineProbeResult: gatewayProbeResult.Outputs.Get(output.Name)})
+		}
+	}
+
+	outputReasons := make(map[string]string)
+	for _, output := range pipeline.Spec.AdditionalOutputs {
+		outputReasons[output.Name] = combineFlowHealthReasons(agentOutputReasons[output.Name], gatewayOutputReasons[output.Name])
 	}
 
 	// Combine results: worst status wins
 	reason := combineFlowHealthReasons(agentReason, gatewayReason)
+	if reason == conditions.ReasonSelfMonFlowHealthy && failoverActive {
+		return metav1.ConditionTrue, conditions.ReasonSelfMonFailoverActive, outputReasons, nil
+	}
+
 	if reason == conditions.ReasonSelfMonFlowHealthy {
-		return metav1.ConditionTrue, reason, nil
+		return metav1.ConditionTrue, reason, outputReasons, nil
 	}
 
-	return metav1.ConditionFalse, reason, nil
+	return metav1.ConditionFalse, reason, outputReasons, nil
 }
 
-// requiresGatewayProbing returns true if the pipeline uses OTLP input
+// requiresGatewayProbing returns true if the pipeline uses OTLP input or events input
 func requiresGatewayProbing(pipeline *telemetryv1beta1.LogPipeline) bool {
-	return pipeline.Spec.Input.OTLP != nil
+	return pipeline.Spec.Input.OTLP != nil || logpipelineutils.IsEventsInputEnabled(&pipeline.Spec.Input)
 }
 
 // requiresAgentProbing returns true if the pipeline uses runtime input
@@ -154,6 +191,8 @@ func gatewayFlowHealthReasonFor(probeResult prober.OTelGatewayProbeResult) strin
 		return conditions.ReasonSelfMonGatewayAllDataDropped
 	case probeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonGatewaySomeDataDropped
+	case probeResult.BufferFillingUp:
+		return conditions.ReasonSelfMonGatewayBufferFillingUp
 	case probeResult.Throttling:
 		return conditions.ReasonSelfMonGatewayThrottling
 	default:
@@ -181,7 +220,8 @@ func combineFlowHealthReasons(agentReason, gatewayReason string) string {
 	}
 
 	for _, reason := range reasons {
-		if reason == conditions.ReasonSelfMonAgentBufferFillingUp {
+		if reason == conditions.ReasonSelfMonAgentBufferFillingUp ||
+			reason == conditions.ReasonSelfMonGatewayBufferFillingUp {
 			return reason
 		}
 	}
@@ -201,6 +241,8 @@ func agentFlowHealthReasonFor(agentProbeResult prober.OTelAgentProbeResult) stri
 		return conditions.ReasonSelfMonAgentAllDataDropped
 	case agentProbeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonAgentSomeDataDropped
+	case agentProbeResult.BufferFillingUp:
+		return conditions.ReasonSelfMonAgentBufferFillingUp
 	default:
 		return conditions.ReasonSelfMonFlowHealthy
 	}
@@ -266,6 +308,18 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 			fmt.Sprintf(conditions.MessageForOtelLogPipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
 	}
 
+	if multiline.IsInvalidMultilinePatternError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonMultilinePatternInvalid,
+			conditions.ConvertErrToMsg(err)
+	}
+
+	if redaction.IsInvalidRedactionPatternError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonRedactionPatternInvalid,
+			conditions.ConvertErrToMsg(err)
+	}
+
 	if APIRequestFailed, _ := errors.AsType[*errortypes.APIRequestFailedError](err); APIRequestFailed != nil {
 		return metav1.ConditionFalse, conditions.ReasonValidationFailed, conditions.MessageForOtelLogPipeline(conditions.ReasonValidationFailed)
 	}
@@ -105,6 +105,8 @@ func newTestValidator(opts ...ValidatorOption) *Validator {
 		WithSecretRefValidator(stubs.NewSecretRefValidator(nil)),
 		WithTransformSpecValidator(stubs.NewTransformSpecValidator(nil)),
 		WithFilterSpecValidator(stubs.NewFilterSpecValidator(nil)),
+		WithMultilineValidator(stubs.NewMultilineValidator(nil)),
+		WithRedactionValidator(stubs.NewRedactionValidator(nil)),
 	}
 
 	allOpts = append(allOpts, opts...)
@@ -0,0 +1,19 @@
+package stubs
+
+import (
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+type MultilineValidator struct {
+	err error
+}
+
+func NewMultilineValidator(err error) *MultilineValidator {
+	return &MultilineValidator{
+		err: err,
+	}
+}
+
+func (m *MultilineValidator) Validate(pipeline *telemetryv1beta1.LogPipeline) error {
+	return m.err
+}
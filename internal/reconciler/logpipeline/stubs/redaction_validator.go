@@ -0,0 +1,19 @@
+package stubs
+
+import (
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+type RedactionValidator struct {
+	err error
+}
+
+func NewRedactionValidator(err error) *RedactionValidator {
+	return &RedactionValidator{
+		err: err,
+	}
+}
+
+func (r *RedactionValidator) Validate(redaction *telemetryv1beta1.RedactionSpec) error {
+	return r.err
+}
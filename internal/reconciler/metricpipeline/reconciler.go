@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -395,7 +397,11 @@ func (r *Reconciler) getPipelinesRequiringAgents(allPipelines []telemetryv1beta1
 func isMetricAgentRequired(pipeline *telemetryv1beta1.MetricPipeline) bool {
 	input := pipeline.Spec.Input
 
-	return metricpipelineutils.IsRuntimeInputEnabled(input) || metricpipelineutils.IsPrometheusInputEnabled(input) || metricpipelineutils.IsIstioInputEnabled(input)
+	return metricpipelineutils.IsRuntimeInputEnabled(input) || metricpipelineutils.IsPrometheusInputEnabled(input) || metricpipelineutils.IsIstioInputEnabled(input) || metricpipelineutils.IsHostInputEnabled(input)
+}
+
+func isHostInputEnabled(pipeline telemetryv1beta1.MetricPipeline) bool {
+	return metricpipelineutils.IsHostInputEnabled(pipeline.Spec.Input)
 }
 
 func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline, allPipelines []telemetryv1beta1.MetricPipeline) error {
@@ -461,13 +467,15 @@ func (r *Reconciler) reconcileMetricAgents(ctx context.Context, pipeline *teleme
 		ctx,
 		k8sclients.NewOwnerReferenceSetter(r.Client, pipeline),
 		otelcollector.AgentApplyOptions{
-			IstioEnabled:        isIstioActive,
-			VpaCRDExists:        vpaCRDExists,
-			VpaEnabled:          isVpaEnabled,
-			VPAMaxAllowedMemory: vpaMaxAllowedMemory,
-			CollectorConfigYAML: string(agentConfigYAML),
-			CollectorEnvVars:    collectorEnvVars,
-			BackendPorts:        backendPorts,
+			IstioEnabled:           isIstioActive,
+			VpaCRDExists:           vpaCRDExists,
+			VpaEnabled:             isVpaEnabled,
+			VPAMaxAllowedMemory:    vpaMaxAllowedMemory,
+			CollectorConfigYAML:    string(agentConfigYAML),
+			CollectorEnvVars:       collectorEnvVars,
+			BackendPorts:           backendPorts,
+			PersistentQueueEnabled: common.HasSendingQueueStorage(agentConfig),
+			HostFSEnabled:          slices.ContainsFunc(allPipelines, isHostInputEnabled),
 		},
 	); err != nil {
 		return fmt.Errorf("failed to apply agent resources: %w", err)
@@ -509,6 +517,10 @@ func (r *Reconciler) trackPipelineInfoMetric(ctx context.Context, pipelines []te
 			features = append(features, metrics.FeatureInputIstio)
 		}
 
+		if metricpipelineutils.IsHostInputEnabled(pipeline.Spec.Input) {
+			features = append(features, metrics.FeatureInputHost)
+		}
+
 		if metricpipelineutils.IsDeltaTemporality(pipeline.Spec.Output) {
 			features = append(features, metrics.FeatureOutputDeltaTemporality)
 		}
@@ -522,11 +534,20 @@ func (r *Reconciler) trackPipelineInfoMetric(ctx context.Context, pipelines []te
 }
 
 func (r *Reconciler) getEndpoint(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) string {
-	if pipeline.Spec.Output.OTLP == nil {
+	var endpoint telemetryv1beta1.ValueType
+
+	switch {
+	case pipeline.Spec.Output.Kafka != nil:
+		return strings.Join(pipeline.Spec.Output.Kafka.Brokers, ",")
+	case pipeline.Spec.Output.PrometheusRemoteWrite != nil:
+		endpoint = pipeline.Spec.Output.PrometheusRemoteWrite.Endpoint
+	case pipeline.Spec.Output.OTLP != nil:
+		endpoint = pipeline.Spec.Output.OTLP.Endpoint
+	default:
 		return ""
 	}
 
-	endpointBytes, err := sharedtypesutils.ResolveValue(ctx, r.Client, pipeline.Spec.Output.OTLP.Endpoint)
+	endpointBytes, err := sharedtypesutils.ResolveValue(ctx, r.Client, endpoint)
 	if err != nil {
 		return ""
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/redaction"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
@@ -297,6 +298,15 @@ func TestGatewayFlowHealthCondition(t *testing.T) {
 			expectedReason:  conditions.ReasonSelfMonGatewayThrottling,
 			expectedMessage: "OTLP Gateway is unable to receive metrics at current rate. See troubleshooting: " + conditions.LinkGatewayThrottling,
 		},
+		{
+			name: "buffer filling up",
+			probe: prober.OTelGatewayProbeResult{
+				BufferFillingUp: true,
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonGatewayBufferFillingUp,
+			expectedMessage: "Buffer nearing capacity. Incoming metric rate exceeds export rate in OTLP Gateway. See troubleshooting: " + conditions.LinkBufferFillingUp,
+		},
 		{
 			name: "some data dropped",
 			probe: prober.OTelGatewayProbeResult{
@@ -375,12 +385,13 @@ func TestGatewayFlowHealthCondition(t *testing.T) {
 
 func TestAgentFlowHealthCondition(t *testing.T) {
 	tests := []struct {
-		name            string
-		probe           prober.OTelAgentProbeResult
-		probeErr        error
-		expectedStatus  metav1.ConditionStatus
-		expectedReason  string
-		expectedMessage string
+		name                    string
+		probe                   prober.OTelAgentProbeResult
+		probeErr                error
+		prometheusInputDisabled bool
+		expectedStatus          metav1.ConditionStatus
+		expectedReason          string
+		expectedMessage         string
 	}{
 		{
 			name:            "prober fails",
@@ -425,10 +436,29 @@ func TestAgentFlowHealthCondition(t *testing.T) {
 			expectedReason:  conditions.ReasonSelfMonAgentAllDataDropped,
 			expectedMessage: "Backend is not reachable or rejecting metrics. All metrics are dropped in Metric Agent. See troubleshooting: " + conditions.LinkNoDataArriveAtBackend,
 		},
+		{
+			name: "sample limit exceeded",
+			probe: prober.OTelAgentProbeResult{
+				SampleLimitExceeded: true,
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonAgentSampleLimitExceeded,
+			expectedMessage: "A Prometheus target exceeds the sample limit and is not scraped by Metric Agent. The target cannot be attributed to a pipeline, so all pipelines with the Prometheus input report this reason. See troubleshooting: " + conditions.LinkSampleLimitExceeded,
+		},
+		{
+			name: "sample limit exceeded without prometheus input",
+			probe: prober.OTelAgentProbeResult{
+				SampleLimitExceeded: true,
+			},
+			prometheusInputDisabled: true,
+			expectedStatus:          metav1.ConditionTrue,
+			expectedReason:          conditions.ReasonSelfMonFlowHealthy,
+			expectedMessage:         "No problems detected in the telemetry flow",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			pipeline := testutils.NewMetricPipelineBuilder().WithPrometheusInput(true).Build()
+			pipeline := testutils.NewMetricPipelineBuilder().WithPrometheusInput(!tt.prometheusInputDisabled).WithRuntimeInput(tt.prometheusInputDisabled).Build()
 			fakeClient := newTestClient(t, &pipeline)
 
 			agentConfigBuilderMock := &mocks.AgentConfigBuilder{}
@@ -660,6 +690,43 @@ func TestRuntimeAdditionalMetricValidation(t *testing.T) {
 	assertAll(t)
 }
 
+func TestRedactionValidation(t *testing.T) {
+	pipeline := testutils.NewMetricPipelineBuilder().
+		WithRedaction(&telemetryv1beta1.RedactionSpec{Patterns: []string{"[0-9"}}).
+		Build()
+	fakeClient := newTestClient(t, &pipeline)
+
+	customValidator := newTestValidator(
+		WithRedactionValidator(stubs.NewRedactionValidator(
+			&redaction.InvalidRedactionPatternError{Err: fmt.Errorf("invalid redaction pattern \"[0-9\": missing closing ]")},
+		)),
+	)
+
+	sut, assertAll := newTestReconciler(
+		fakeClient,
+		WithPipelineValidator(customValidator),
+	)
+
+	result := reconcileAndGet(t, fakeClient, sut, pipeline.Name)
+	require.NoError(t, result.err)
+
+	requireHasStatusCondition(t, result.pipeline,
+		conditions.TypeConfigurationGenerated,
+		metav1.ConditionFalse,
+		conditions.ReasonRedactionPatternInvalid,
+		"Invalid redaction pattern \"[0-9\": missing closing ]",
+	)
+
+	requireHasStatusCondition(t, result.pipeline,
+		conditions.TypeFlowHealthy,
+		metav1.ConditionFalse,
+		conditions.ReasonSelfMonConfigNotGenerated,
+		"No metrics delivered to backend because MetricPipeline specification is not applied to the configuration of OTLP Gateway. Check the 'ConfigurationGenerated' condition for more details",
+	)
+
+	assertAll(t)
+}
+
 func TestAPIServerFailureHandling(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1096,6 +1163,19 @@ func TestUsageTracking(t *testing.T) {
 				metrics.FeatureInputIstio,
 			},
 		},
+		{
+			name: "pipeline with host input",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithName("pipeline-host").
+				WithOTLPInput(false).
+				WithHostInput(true).
+				WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("test")).
+				Build(),
+			expectedEndpoint: "test",
+			expectedFeatureUsage: []string{
+				metrics.FeatureInputHost,
+			},
+		},
 		{
 			name: "pipeline with delta temporality output",
 			pipeline: testutils.NewMetricPipelineBuilder().
@@ -1184,6 +1264,16 @@ func TestUsageTracking(t *testing.T) {
 			expectedEndpoint:     "endpoint.example.com",
 			expectedFeatureUsage: []string{},
 		},
+		{
+			name: "prometheus remote-write output",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithName("pipeline-prometheus-remote-write").
+				WithOTLPInput(false).
+				WithPrometheusRemoteWriteOutput(testutils.OTLPEndpoint("https://prometheus.example.com/api/v1/write")).
+				Build(),
+			expectedEndpoint:     "https://prometheus.example.com/api/v1/write",
+			expectedFeatureUsage: []string{},
+		},
 		{
 			name: "non-reconcilable pipeline with invalid transform",
 			pipeline: testutils.NewMetricPipelineBuilder().
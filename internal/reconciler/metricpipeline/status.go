@@ -19,8 +19,10 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/resourcelock"
 	"github.com/kyma-project/telemetry-manager/internal/resources/names"
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/redaction"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
@@ -134,6 +136,12 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 			conditions.ConvertErrToMsg(err)
 	}
 
+	if redaction.IsInvalidRedactionPatternError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonRedactionPatternInvalid,
+			conditions.ConvertErrToMsg(err)
+	}
+
 	if APIRequestFailed, _ := errors.AsType[*errortypes.APIRequestFailedError](err); APIRequestFailed != nil {
 		return metav1.ConditionFalse, conditions.ReasonValidationFailed, conditions.MessageForMetricPipeline(conditions.ReasonValidationFailed)
 	}
@@ -142,7 +150,7 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 }
 
 func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) error {
-	status, reason, err := r.evaluateFlowHealthCondition(ctx, pipeline)
+	status, reason, outputReasons, err := r.evaluateFlowHealthCondition(ctx, pipeline)
 
 	condition := metav1.Condition{
 		Type:               conditions.TypeFlowHealthy,
@@ -153,19 +161,22 @@ func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telem
 	}
 
 	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+	commonstatus.SetOutputFlowHealthConditions(&pipeline.Status.Conditions, condition, pipelines.MetricPipelineOutputs(pipeline), outputReasons, conditions.MessageForMetricPipeline)
 
 	return err
 }
 
-func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) (metav1.ConditionStatus, string, error) {
+// evaluateFlowHealthCondition returns the status and reason of the pipeline-level flow health condition,
+// together with the flow health reasons of the additional outputs keyed by output name.
+func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) (metav1.ConditionStatus, string, map[string]string, error) {
 	configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
 	if configGeneratedStatus == metav1.ConditionFalse {
-		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, nil
+		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, nil, nil
 	}
 
 	gatewayProbeResult, err := r.gatewayFlowHealthProber.Probe(ctx, pipeline.Name)
 	if err != nil {
-		return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, fmt.Errorf("failed to probe gateway flow health: %w", err)
+		return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, nil, fmt.Errorf("failed to probe gateway flow health: %w", err)
 	}
 
 	logf.FromContext(ctx).V(1).Info("Probed gateway flow health", "result", gatewayProbeResult)
@@ -173,17 +184,40 @@ func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *
 	// Probe agent flow health
 	agentProbeResult, err := r.agentFlowHealthProber.Probe(ctx, pipeline.Name)
 	if err != nil {
-		return metav1.ConditionUnknown, conditions.ReasonSelfMonAgentProbingFailed, fmt.Errorf("failed to probe agent flow health: %w", err)
+		return metav1.ConditionUnknown, conditions.ReasonSelfMonAgentProbingFailed, nil, fmt.Errorf("failed to probe agent flow health: %w", err)
 	}
 
 	logf.FromContext(ctx).V(1).Info("Probed agent flow health", "result", agentProbeResult)
 
+	// The scrape targets exceeding the sample limit cannot be attributed to a pipeline, so they only affect the pipelines with the Prometheus input
+	if !metricpipelineutils.IsPrometheusInputEnabled(pipeline.Spec.Input) {
+		agentProbeResult.SampleLimitExceeded = false
+	}
+
+	outputReasons := make(map[string]string)
+	for _, output := range pipeline.Spec.AdditionalOutputs {
+		outputReasons[output.Name] = flowHealthReasonFor(
+			prober.OTelGatewayProbeResult{PipelineProbeResult: gatewayProbeResult.Outputs.Get(output.Name)},
+			prober.OTelAgentProbeResult{PipelineProbeResult: agentProbeResult.Outputs.Get(output.Name)},
+		)
+	}
+
+	// While the failover output is active, the data drops of the primary output are expected
+	failoverActive := pipeline.Spec.Output.Failover != nil && gatewayProbeResult.OnlyPrimaryOutputDropping()
+	if failoverActive {
+		gatewayProbeResult.PipelineProbeResult = prober.PipelineProbeResult{Healthy: true}
+	}
+
 	reason := flowHealthReasonFor(gatewayProbeResult, agentProbeResult)
+	if reason == conditions.ReasonSelfMonFlowHealthy && failoverActive {
+		return metav1.ConditionTrue, conditions.ReasonSelfMonFailoverActive, outputReasons, nil
+	}
+
 	if reason == conditions.ReasonSelfMonFlowHealthy {
-		return metav1.ConditionTrue, reason, nil
+		return metav1.ConditionTrue, reason, outputReasons, nil
 	}
 
-	return metav1.ConditionFalse, reason, nil
+	return metav1.ConditionFalse, reason, outputReasons, nil
 }
 
 func flowHealthReasonFor(gatewayProbeResult prober.OTelGatewayProbeResult, agentProbeResult prober.OTelAgentProbeResult) string {
@@ -192,12 +226,18 @@ func flowHealthReasonFor(gatewayProbeResult prober.OTelGatewayProbeResult, agent
 		return conditions.ReasonSelfMonGatewayAllDataDropped
 	case gatewayProbeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonGatewaySomeDataDropped
+	case gatewayProbeResult.BufferFillingUp:
+		return conditions.ReasonSelfMonGatewayBufferFillingUp
 	case gatewayProbeResult.Throttling:
 		return conditions.ReasonSelfMonGatewayThrottling
 	case agentProbeResult.AllDataDropped:
 		return conditions.ReasonSelfMonAgentAllDataDropped
 	case agentProbeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonAgentSomeDataDropped
+	case agentProbeResult.BufferFillingUp:
+		return conditions.ReasonSelfMonAgentBufferFillingUp
+	case agentProbeResult.SampleLimitExceeded:
+		return conditions.ReasonSelfMonAgentSampleLimitExceeded
 	default:
 		return conditions.ReasonSelfMonFlowHealthy
 	}
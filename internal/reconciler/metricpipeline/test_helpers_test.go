@@ -127,6 +127,7 @@ func newTestValidator(opts ...ValidatorOption) *Validator {
 		WithTransformSpecValidator(stubs.NewTransformSpecValidator(nil)),
 		WithFilterSpecValidator(stubs.NewFilterSpecValidator(nil)),
 		WithRuntimeAdditionalMetricsValidator(stubs.NewRuntimeAdditionalMetricsValidator(nil)),
+		WithRedactionValidator(stubs.NewRedactionValidator(nil)),
 	}
 
 	allOpts = append(allOpts, opts...)
@@ -181,6 +181,9 @@ func (r *Reconciler) processConfigAndBuildResources(ctx context.Context, tracePi
 		VpaCRDExists:                   vpaCRDExists,
 		VpaEnabled:                     vpaEnabled,
 		VPAMaxAllowedMemory:            vpaMaxAllowedMemory,
+		PersistentQueueEnabled:         common.HasSendingQueueStorage(collectorConfig),
+		TraceSamplingEnabled:           common.HasTraceSamplingReceiver(collectorConfig),
+		PrometheusExpositionEnabled:    common.HasPrometheusExporter(collectorConfig),
 	}
 
 	return r.gatewayApplierDeleter.ApplyResources(ctx, r.Client, opts)
@@ -85,3 +85,11 @@ type SecretWatcher interface {
 	// RemoveFromWatchers removes a pipeline from all watchers by name and GVK.
 	RemoveFromWatchers(ctx context.Context, name string, gvk schema.GroupVersionKind) error
 }
+
+// RedactionValidator validates the redaction of sensitive data in trace pipeline configurations.
+// It ensures that the custom patterns are valid regular expressions.
+type RedactionValidator interface {
+	// Validate checks if all custom redaction patterns compile.
+	// Returns an error if any pattern is invalid.
+	Validate(redaction *telemetryv1beta1.RedactionSpec) error
+}
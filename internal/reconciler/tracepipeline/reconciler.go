@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -318,6 +319,10 @@ func (r *Reconciler) trackPipelineInfoMetric(ctx context.Context, pipelines []te
 }
 
 func (r *Reconciler) getEndpoint(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) string {
+	if pipeline.Spec.Output.Kafka != nil {
+		return strings.Join(pipeline.Spec.Output.Kafka.Brokers, ",")
+	}
+
 	if pipeline.Spec.Output.OTLP == nil {
 		return ""
 	}
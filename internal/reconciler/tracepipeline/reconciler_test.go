@@ -314,6 +314,15 @@ func TestFlowHealthCondition(t *testing.T) {
 			expectedReason:  conditions.ReasonSelfMonGatewayThrottling,
 			expectedMessage: "OTLP Gateway is unable to receive spans at current rate. See troubleshooting: " + conditions.LinkGatewayThrottling,
 		},
+		{
+			name: "buffer filling up",
+			probe: prober.OTelGatewayProbeResult{
+				BufferFillingUp: true,
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  conditions.ReasonSelfMonGatewayBufferFillingUp,
+			expectedMessage: "Buffer nearing capacity. Incoming span rate exceeds export rate in OTLP Gateway. See troubleshooting: " + conditions.LinkBufferFillingUp,
+		},
 		{
 			name: "some data dropped",
 			probe: prober.OTelGatewayProbeResult{
@@ -730,6 +739,15 @@ func TestPipelineInfoTracking(t *testing.T) {
 			expectedEndpoint:     "endpoint.example.com",
 			expectedFeatureUsage: []string{},
 		},
+		{
+			name: "kafka output",
+			pipeline: testutils.NewTracePipelineBuilder().
+				WithName("pipeline-kafka").
+				WithKafkaOutput(testutils.KafkaBrokers("kafka-0:9092", "kafka-1:9092")).
+				Build(),
+			expectedEndpoint:     "kafka-0:9092,kafka-1:9092",
+			expectedFeatureUsage: []string{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -784,6 +802,199 @@ func buildTracePipelineLabelValues(pipelineName, endpoint string, enabledFeature
 	return labelValues
 }
 
+// TestSamplingStatus verifies that the configured default percentage and the effective percentages of the included namespaces are reported in the status
+func TestSamplingStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		sampling *telemetryv1beta1.TracePipelineSampling
+		expected *telemetryv1beta1.TracePipelineSamplingStatus
+	}{
+		{
+			name:     "no sampling",
+			sampling: nil,
+			expected: nil,
+		},
+		{
+			name: "tail-based sampling only",
+			sampling: &telemetryv1beta1.TracePipelineSampling{
+				Tail: &telemetryv1beta1.TraceTailSampling{
+					Policies: []telemetryv1beta1.TraceSamplingPolicy{
+						{Name: "keep-all", Probabilistic: &telemetryv1beta1.ProbabilisticSamplingPolicy{Percentage: 100}},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "head-based sampling with overrides",
+			sampling: &telemetryv1beta1.TracePipelineSampling{
+				Head: &telemetryv1beta1.TraceHeadSampling{
+					Percentage: 10,
+					Overrides: []telemetryv1beta1.TraceHeadSamplingOverride{
+						{Namespaces: telemetryv1beta1.NamespaceSelector{Include: []string{"payments"}}, Percentage: 100},
+						{Namespaces: telemetryv1beta1.NamespaceSelector{Exclude: []string{"production"}}, Percentage: 0},
+						{Namespaces: telemetryv1beta1.NamespaceSelector{Include: []string{"production", "payments"}}, Percentage: 50},
+					},
+				},
+			},
+			expected: &telemetryv1beta1.TracePipelineSamplingStatus{
+				Percentage: 10,
+				Namespaces: []telemetryv1beta1.NamespaceSamplingStatus{
+					{Name: "payments", Percentage: 100},
+					{Name: "production", Percentage: 50},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewTracePipelineBuilder().
+				WithName("sampled-pipeline").
+				WithOTLPOutput(testutils.OTLPEndpoint("http://backend:4317")).
+				WithSampling(tt.sampling).
+				Build()
+
+			fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+			flowHealthProberStub := &mocks.FlowHealthProber{}
+			flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelGatewayProbeResult{
+				PipelineProbeResult: prober.PipelineProbeResult{Healthy: true},
+			}, nil).Maybe()
+
+			sut := testReconciler(fakeClient, flowHealthProberStub)
+
+			_, err := sut.Reconcile(context.Background(), requestFor(pipeline.Name))
+			require.NoError(t, err)
+
+			var updatedPipeline telemetryv1beta1.TracePipeline
+
+			err = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, updatedPipeline.Status.Sampling)
+		})
+	}
+}
+
+// TestAdditionalOutputFlowHealthStatus verifies that every additional output gets its own flow health sub-condition
+func TestAdditionalOutputFlowHealthStatus(t *testing.T) {
+	pipeline := testutils.NewTracePipelineBuilder().
+		WithName("fan-out").
+		WithOTLPOutput(testutils.OTLPEndpoint("http://backend:4317")).
+		WithAdditionalOTLPOutput("archive", testutils.OTLPEndpoint("http://archive:4317")).
+		WithAdditionalOTLPOutput("audit", testutils.OTLPEndpoint("http://audit:4317")).
+		Build()
+	pipeline.Status.Conditions = []metav1.Condition{
+		{Type: conditions.TypeFlowHealthyForOutput("removed"), Status: metav1.ConditionTrue, Reason: conditions.ReasonSelfMonFlowHealthy},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+	flowHealthProberStub := &mocks.FlowHealthProber{}
+	flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(prober.OTelGatewayProbeResult{
+		PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true},
+		Outputs: prober.OutputProbeResults{
+			"archive": {AllDataDropped: true},
+		},
+	}, nil)
+
+	sut := testReconciler(fakeClient, flowHealthProberStub)
+
+	_, err := sut.Reconcile(context.Background(), requestFor(pipeline.Name))
+	require.NoError(t, err)
+
+	var updatedPipeline telemetryv1beta1.TracePipeline
+
+	err = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+	require.NoError(t, err)
+
+	requireHasStatusCondition(t, &updatedPipeline,
+		conditions.TypeFlowHealthy,
+		metav1.ConditionFalse,
+		conditions.ReasonSelfMonGatewayAllDataDropped,
+		conditions.MessageForTracePipeline(conditions.ReasonSelfMonGatewayAllDataDropped),
+	)
+	requireHasStatusCondition(t, &updatedPipeline,
+		conditions.TypeFlowHealthyForOutput("archive"),
+		metav1.ConditionFalse,
+		conditions.ReasonSelfMonGatewayAllDataDropped,
+		conditions.MessageForTracePipeline(conditions.ReasonSelfMonGatewayAllDataDropped),
+	)
+	requireHasStatusCondition(t, &updatedPipeline,
+		conditions.TypeFlowHealthyForOutput("audit"),
+		metav1.ConditionTrue,
+		conditions.ReasonSelfMonFlowHealthy,
+		conditions.MessageForTracePipeline(conditions.ReasonSelfMonFlowHealthy),
+	)
+	require.Nil(t, getCondition(&updatedPipeline, conditions.TypeFlowHealthyForOutput("removed")))
+}
+
+func TestFailoverOutputFlowHealthStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		probe          prober.OTelGatewayProbeResult
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "primary output healthy",
+			probe:          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{Healthy: true}},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: conditions.ReasonSelfMonFlowHealthy,
+		},
+		{
+			name:           "primary output dropping data",
+			probe:          prober.OTelGatewayProbeResult{PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true}},
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: conditions.ReasonSelfMonFailoverActive,
+		},
+		{
+			name: "primary and failover outputs dropping data",
+			probe: prober.OTelGatewayProbeResult{
+				PipelineProbeResult: prober.PipelineProbeResult{AllDataDropped: true},
+				Outputs: prober.OutputProbeResults{
+					"failover": {AllDataDropped: true},
+				},
+			},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: conditions.ReasonSelfMonGatewayAllDataDropped,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := testutils.NewTracePipelineBuilder().
+				WithName("failover").
+				WithOTLPOutput(testutils.OTLPEndpoint("http://backend:4317")).
+				WithFailoverOTLPOutput(testutils.OTLPEndpoint("http://secondary:4317")).
+				Build()
+
+			fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(&pipeline).WithStatusSubresource(&pipeline).Build()
+
+			flowHealthProberStub := &mocks.FlowHealthProber{}
+			flowHealthProberStub.On("Probe", mock.Anything, pipeline.Name).Return(tt.probe, nil)
+
+			sut := testReconciler(fakeClient, flowHealthProberStub)
+
+			_, err := sut.Reconcile(context.Background(), requestFor(pipeline.Name))
+			require.NoError(t, err)
+
+			var updatedPipeline telemetryv1beta1.TracePipeline
+
+			err = fakeClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Name}, &updatedPipeline)
+			require.NoError(t, err)
+
+			requireHasStatusCondition(t, &updatedPipeline,
+				conditions.TypeFlowHealthy,
+				tt.expectedStatus,
+				tt.expectedReason,
+				conditions.MessageForTracePipeline(tt.expectedReason),
+			)
+			require.Nil(t, getCondition(&updatedPipeline, conditions.TypeFlowHealthyForOutput("failover")))
+		})
+	}
+}
+
 // TestDeletingPipeline verifies that deleting pipelines are properly handled
 func TestDeletingPipeline(t *testing.T) {
 	now := metav1.Now()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -21,11 +22,12 @@ import (
 	"github.com/kyma-project/telemetry-manager/internal/selfmonitor/prober"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/ottl"
+	"github.com/kyma-project/telemetry-manager/internal/validators/redaction"
 	"github.com/kyma-project/telemetry-manager/internal/validators/secretref"
 )
 
 // updateStatus updates the status of a TracePipeline resource.
-// It sets the GatewayHealthy, ConfigurationGenerated and TelemetryFlowHealthy conditions.
+// It sets the GatewayHealthy, ConfigurationGenerated and TelemetryFlowHealthy conditions and the effective sampling percentages.
 func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) error {
 	var pipeline telemetryv1beta1.TracePipeline
 	if err := r.Get(ctx, types.NamespacedName{Name: pipelineName}, &pipeline); err != nil {
@@ -51,6 +53,8 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 		allErrors = errors.Join(allErrors, err)
 	}
 
+	setSamplingStatus(&pipeline)
+
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to update TracePipeline status: %w", err))
 	}
@@ -58,6 +62,52 @@ func (r *Reconciler) updateStatus(ctx context.Context, pipelineName string) erro
 	return allErrors
 }
 
+// setSamplingStatus reports the configured default head-based sampling percentage of the pipeline.
+// For every namespace that is explicitly included by an override, the effective percentage of the first matching override is reported.
+func setSamplingStatus(pipeline *telemetryv1beta1.TracePipeline) {
+	if pipeline.Spec.Sampling == nil || pipeline.Spec.Sampling.Head == nil {
+		pipeline.Status.Sampling = nil
+		return
+	}
+
+	head := pipeline.Spec.Sampling.Head
+	samplingStatus := &telemetryv1beta1.TracePipelineSamplingStatus{
+		Percentage: head.Percentage,
+	}
+
+	for _, override := range head.Overrides {
+		for _, namespace := range override.Namespaces.Include {
+			if slices.ContainsFunc(samplingStatus.Namespaces, func(ns telemetryv1beta1.NamespaceSamplingStatus) bool {
+				return ns.Name == namespace
+			}) {
+				continue
+			}
+
+			samplingStatus.Namespaces = append(samplingStatus.Namespaces, telemetryv1beta1.NamespaceSamplingStatus{
+				Name:       namespace,
+				Percentage: effectiveHeadSamplingPercentage(head, namespace),
+			})
+		}
+	}
+
+	pipeline.Status.Sampling = samplingStatus
+}
+
+// effectiveHeadSamplingPercentage returns the percentage of the first override matching the namespace, or the default percentage if no override matches.
+func effectiveHeadSamplingPercentage(head *telemetryv1beta1.TraceHeadSampling, namespace string) int32 {
+	for _, override := range head.Overrides {
+		if len(override.Namespaces.Include) > 0 && slices.Contains(override.Namespaces.Include, namespace) {
+			return override.Percentage
+		}
+
+		if len(override.Namespaces.Include) == 0 && !slices.Contains(override.Namespaces.Exclude, namespace) {
+			return override.Percentage
+		}
+	}
+
+	return head.Percentage
+}
+
 func (r *Reconciler) setGatewayHealthyCondition(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) {
 	configStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
 	condition := commonstatus.GetGatewayHealthyCondition(ctx,
@@ -114,6 +164,12 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 			fmt.Sprintf(conditions.MessageForTracePipeline(conditions.ReasonOTTLSpecInvalid), err.Error())
 	}
 
+	if redaction.IsInvalidRedactionPatternError(err) {
+		return metav1.ConditionFalse,
+			conditions.ReasonRedactionPatternInvalid,
+			conditions.ConvertErrToMsg(err)
+	}
+
 	if APIRequestFailed, _ := errors.AsType[*errortypes.APIRequestFailedError](err); APIRequestFailed != nil {
 		return metav1.ConditionFalse, conditions.ReasonValidationFailed, conditions.MessageForTracePipeline(conditions.ReasonValidationFailed)
 	}
@@ -122,7 +178,7 @@ func (r *Reconciler) evaluateConfigGeneratedCondition(ctx context.Context, pipel
 }
 
 func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) error {
-	status, reason, err := r.evaluateFlowHealthCondition(ctx, pipeline)
+	status, reason, outputReasons, err := r.evaluateFlowHealthCondition(ctx, pipeline)
 
 	condition := metav1.Condition{
 		Type:               conditions.TypeFlowHealthy,
@@ -133,29 +189,42 @@ func (r *Reconciler) setFlowHealthCondition(ctx context.Context, pipeline *telem
 	}
 
 	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+	commonstatus.SetOutputFlowHealthConditions(&pipeline.Status.Conditions, condition, pipelines.TracePipelineOutputs(pipeline), outputReasons, conditions.MessageForTracePipeline)
 
 	return err
 }
 
-func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) (metav1.ConditionStatus, string, error) {
+// evaluateFlowHealthCondition returns the status and reason of the pipeline-level flow health condition,
+// together with the flow health reasons of the additional outputs keyed by output name.
+func (r *Reconciler) evaluateFlowHealthCondition(ctx context.Context, pipeline *telemetryv1beta1.TracePipeline) (metav1.ConditionStatus, string, map[string]string, error) {
 	configGeneratedStatus, _, _ := r.evaluateConfigGeneratedCondition(ctx, pipeline)
 	if configGeneratedStatus == metav1.ConditionFalse {
-		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, nil
+		return metav1.ConditionFalse, conditions.ReasonSelfMonConfigNotGenerated, nil, nil
 	}
 
 	probeResult, err := r.flowHealthProber.Probe(ctx, pipeline.Name)
 	if err != nil {
-		return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, fmt.Errorf("failed to probe flow health: %w", err)
+		return metav1.ConditionUnknown, conditions.ReasonSelfMonGatewayProbingFailed, nil, fmt.Errorf("failed to probe flow health: %w", err)
 	}
 
 	logf.FromContext(ctx).V(1).Info("Probed flow health", "result", probeResult)
 
+	outputReasons := make(map[string]string)
+	for _, output := range pipeline.Spec.AdditionalOutputs {
+		outputReasons[output.Name] = flowHealthReasonFor(prober.OTelGatewayProbeResult{PipelineProbeResult: probeResult.Outputs.Get(output.Name)})
+	}
+
+	// While the failover output is active, the data drops of the primary output are expected
+	if pipeline.Spec.Output.Failover != nil && probeResult.OnlyPrimaryOutputDropping() {
+		return metav1.ConditionTrue, conditions.ReasonSelfMonFailoverActive, outputReasons, nil
+	}
+
 	reason := flowHealthReasonFor(probeResult)
 	if probeResult.Healthy {
-		return metav1.ConditionTrue, reason, nil
+		return metav1.ConditionTrue, reason, outputReasons, nil
 	}
 
-	return metav1.ConditionFalse, reason, nil
+	return metav1.ConditionFalse, reason, outputReasons, nil
 }
 
 func flowHealthReasonFor(probeResult prober.OTelGatewayProbeResult) string {
@@ -164,6 +233,8 @@ func flowHealthReasonFor(probeResult prober.OTelGatewayProbeResult) string {
 		return conditions.ReasonSelfMonGatewayAllDataDropped
 	case probeResult.SomeDataDropped:
 		return conditions.ReasonSelfMonGatewaySomeDataDropped
+	case probeResult.BufferFillingUp:
+		return conditions.ReasonSelfMonGatewayBufferFillingUp
 	case probeResult.Throttling:
 		return conditions.ReasonSelfMonGatewayThrottling
 	default:
@@ -64,6 +64,7 @@ func newTestValidator(opts ...ValidatorOption) *Validator {
 		TLSCertValidator:       stubs.NewTLSCertValidator(nil),
 		FilterSpecValidator:    stubs.NewFilterSpecValidator(nil),
 		TransformSpecValidator: stubs.NewTransformSpecValidator(nil),
+		RedactionValidator:     stubs.NewRedactionValidator(nil),
 		EndpointValidator:      stubs.NewEndpointValidator(nil),
 		PipelineLock:           stubs.NewPipelineLock(),
 	}
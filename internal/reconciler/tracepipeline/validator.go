@@ -4,6 +4,7 @@ import (
 	"context"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
 	"github.com/kyma-project/telemetry-manager/internal/validators/tlscert"
 )
@@ -16,6 +17,7 @@ type Validator struct {
 	PipelineLock           PipelineLock
 	TransformSpecValidator TransformSpecValidator
 	FilterSpecValidator    FilterSpecValidator
+	RedactionValidator     RedactionValidator
 }
 
 // ValidatorOption configures the Validator during initialization.
@@ -63,6 +65,13 @@ func WithFilterSpecValidator(validator FilterSpecValidator) ValidatorOption {
 	}
 }
 
+// WithRedactionValidator sets the redaction validator for the Validator.
+func WithRedactionValidator(validator RedactionValidator) ValidatorOption {
+	return func(v *Validator) {
+		v.RedactionValidator = validator
+	}
+}
+
 // NewValidator creates a new Validator with the provided options.
 func NewValidator(opts ...ValidatorOption) *Validator {
 	v := &Validator{}
@@ -79,30 +88,14 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1beta1.Tra
 		return err
 	}
 
-	if pipeline.Spec.Output.OTLP != nil {
-		var oauth2 *telemetryv1beta1.OAuth2Options = nil
-		if pipeline.Spec.Output.OTLP.Authentication != nil {
-			oauth2 = pipeline.Spec.Output.OTLP.Authentication.OAuth2
-		}
-
-		if err := v.EndpointValidator.Validate(ctx, endpoint.EndpointValidationParams{
-			Endpoint:   &pipeline.Spec.Output.OTLP.Endpoint,
-			Protocol:   pipeline.Spec.Output.OTLP.Protocol,
-			OutputTLS:  pipeline.Spec.Output.OTLP.TLS,
-			OTLPOAuth2: oauth2,
-		}); err != nil {
+	for _, output := range pipelines.TracePipelineOutputs(pipeline) {
+		if err := v.validateOTLPOutput(ctx, output.OTLP); err != nil {
 			return err
 		}
 	}
 
-	if tlsValidationRequired(pipeline) {
-		tlsConfig := tlscert.TLSValidationParams{
-			Cert: pipeline.Spec.Output.OTLP.TLS.Cert,
-			Key:  pipeline.Spec.Output.OTLP.TLS.Key,
-			CA:   pipeline.Spec.Output.OTLP.TLS.CA,
-		}
-
-		if err := v.TLSCertValidator.Validate(ctx, tlsConfig); err != nil {
+	for _, output := range pipelines.TracePipelineKafkaOutputs(pipeline) {
+		if err := v.validateKafkaOutput(ctx, output.Kafka); err != nil {
 			return err
 		}
 	}
@@ -119,18 +112,62 @@ func (v *Validator) validate(ctx context.Context, pipeline *telemetryv1beta1.Tra
 		return err
 	}
 
+	if err := v.RedactionValidator.Validate(pipeline.Spec.Redaction); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func tlsValidationRequired(pipeline *telemetryv1beta1.TracePipeline) bool {
-	otlp := pipeline.Spec.Output.OTLP
-	if otlp == nil {
-		return false
+func (v *Validator) validateOTLPOutput(ctx context.Context, otlp *telemetryv1beta1.OTLPOutput) error {
+	var oauth2 *telemetryv1beta1.OAuth2Options = nil
+	if otlp.Authentication != nil {
+		oauth2 = otlp.Authentication.OAuth2
 	}
 
-	if otlp.TLS == nil {
+	if err := v.EndpointValidator.Validate(ctx, endpoint.EndpointValidationParams{
+		Endpoint:   &otlp.Endpoint,
+		Protocol:   otlp.Protocol,
+		OutputTLS:  otlp.TLS,
+		OTLPOAuth2: oauth2,
+	}); err != nil {
+		return err
+	}
+
+	return v.validateTLS(ctx, otlp.TLS)
+}
+
+func (v *Validator) validateKafkaOutput(ctx context.Context, kafka *telemetryv1beta1.KafkaOutput) error {
+	for _, broker := range kafka.Brokers {
+		if err := v.EndpointValidator.Validate(ctx, endpoint.EndpointValidationParams{
+			Endpoint: &telemetryv1beta1.ValueType{Value: broker},
+			Protocol: endpoint.KafkaProtocol,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return v.validateTLS(ctx, kafka.TLS)
+}
+
+func (v *Validator) validateTLS(ctx context.Context, tls *telemetryv1beta1.OutputTLS) error {
+	if !tlsValidationRequired(tls) {
+		return nil
+	}
+
+	return v.TLSCertValidator.Validate(ctx, tlscert.TLSValidationParams{
+		Cert: tls.Cert,
+		Key:  tls.Key,
+		CA:   tls.CA,
+	})
+}
+
+// tlsValidationRequired checks if TLS validation is required for an output.
+// Returns true if the output has a TLS configuration containing cert, key, or CA.
+func tlsValidationRequired(tls *telemetryv1beta1.OutputTLS) bool {
+	if tls == nil {
 		return false
 	}
 
-	return otlp.TLS.Cert != nil || otlp.TLS.Key != nil || otlp.TLS.CA != nil
+	return tls.Cert != nil || tls.Key != nil || tls.CA != nil
 }
@@ -15,6 +15,10 @@ const (
 	LogAgentMetricsService    = LogAgent + metricsSuffix
 	MetricAgentMetricsService = MetricAgent + metricsSuffix
 	OTLPGatewayMetricsService = OTLPGateway + metricsSuffix
+	// OTLPGatewayTraceSamplingService is a headless service used by the load-balancing exporter to route spans of the same trace to the same gateway instance
+	OTLPGatewayTraceSamplingService = OTLPGateway + "-trace-sampling"
+	// OTLPGatewayPrometheusService exposes the metrics of pipelines with a Prometheus output for scraping
+	OTLPGatewayPrometheusService = OTLPGateway + "-prometheus"
 
 	FluentBit                       = telemetryPrefix + "fluent-bit"
 	FluentBitMetricsService         = FluentBit + metricsSuffix
@@ -58,6 +62,10 @@ const (
 
 	VpaGroupVersion = "autoscaling.k8s.io/v1"
 	VpaKind         = "VerticalPodAutoscaler"
+
+	MonitoringGroupVersion = "monitoring.coreos.com/v1"
+	ServiceMonitorKind     = "ServiceMonitor"
+	PodMonitorKind         = "PodMonitor"
 )
 
 // MetricsServiceName returns the metrics service name for a given component name
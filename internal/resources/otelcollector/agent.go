@@ -36,6 +36,16 @@ const (
 	CheckpointVolumePath = "/tmp"
 	logVolumeName        = "varlogpods"
 	logVolumePath        = "/var/log/pods"
+
+	hostFSVolumeName = "hostfs"
+	// HostFSVolumePath is the mount path of the root filesystem of the node, from which the host metrics are collected.
+	HostFSVolumePath = "/hostfs"
+
+	sendingQueueVolumeName = "sending-queue"
+	// SendingQueueVolumePath is the mount path of the volume that stores the persistent sending queues of the exporters.
+	// The volume is backed by a world-writable host directory, every component stores its queues in its own subdirectory.
+	SendingQueueVolumePath = "/var/lib/otelcol/sending-queue"
+	sendingQueueHostPath   = "/tmp"
 )
 
 var (
@@ -66,6 +76,10 @@ type AgentApplyOptions struct {
 	CollectorEnvVars    map[string][]byte
 	// BackendPorts is needed only for the Metric Agent to set the value of the annotation "traffic.sidecar.istio.io/includeOutboundPorts"
 	BackendPorts []string
+	// PersistentQueueEnabled mounts the volume for persistent sending queues if at least one output uses one
+	PersistentQueueEnabled bool
+	// HostFSEnabled mounts the root filesystem of the node read-only if at least one pipeline collects host metrics. Only relevant for the Metric Agent.
+	HostFSEnabled bool
 }
 
 func NewLogAgentApplierDeleter(globals config.Global, collectorImage, priorityClassName string) *AgentApplierDeleter {
@@ -262,6 +276,16 @@ func (aad *AgentApplierDeleter) makeAgentDaemonSet(configChecksum string, opts A
 	containerOpts := slices.Clone(aad.containerOpts)
 	containerOpts = append(containerOpts, commonresources.WithClusterTrustBundleVolumeMount(aad.globals.ClusterTrustBundleName()))
 
+	if opts.PersistentQueueEnabled {
+		podOpts = append(podOpts, commonresources.WithVolumes([]corev1.Volume{makeSendingQueueVolume()}))
+		containerOpts = append(containerOpts, commonresources.WithVolumeMounts([]corev1.VolumeMount{makeSendingQueueVolumeMount()}))
+	}
+
+	if opts.HostFSEnabled {
+		podOpts = append(podOpts, commonresources.WithVolumes([]corev1.Volume{makeHostFSVolume()}))
+		containerOpts = append(containerOpts, commonresources.WithVolumeMounts([]corev1.VolumeMount{makeHostFSVolumeMount()}))
+	}
+
 	// When VPA is active, override the memory limit to 2x the memory request so the VPA can scale within a tighter range.
 	// This replaces the default high memory limit (agentMemoryLimit) set during construction.
 	// For more details, check the ADR: https://github.com/kyma-project/telemetry-manager/blob/main/docs/contributor/arch/032-vertical-pod-autoscaler-VPA-architecture.md
@@ -380,6 +404,27 @@ func makePodLogsVolumeMount() corev1.VolumeMount {
 	}
 }
 
+func makeHostFSVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: hostFSVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: "/",
+			},
+		},
+	}
+}
+
+func makeHostFSVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      hostFSVolumeName,
+		MountPath: HostFSVolumePath,
+		ReadOnly:  true,
+		// The mounts of the node, like the data disks, are propagated to the container, so that their filesystem usage can be collected.
+		MountPropagation: ptr.To(corev1.MountPropagationHostToContainer),
+	}
+}
+
 func makeFileLogCheckpointVolume() corev1.Volume {
 	return corev1.Volume{
 		Name: checkpointVolumeName,
@@ -399,6 +444,25 @@ func makeFileLogCheckPointVolumeMount() corev1.VolumeMount {
 	}
 }
 
+func makeSendingQueueVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: sendingQueueVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: sendingQueueHostPath,
+				Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+			},
+		},
+	}
+}
+
+func makeSendingQueueVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      sendingQueueVolumeName,
+		MountPath: SendingQueueVolumePath,
+	}
+}
+
 func agentIngressMetricsPorts(istioEnabled bool) []int32 {
 	metricsPorts := []int32{ports.Metrics}
 
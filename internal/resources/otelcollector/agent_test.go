@@ -46,6 +46,8 @@ func TestAgent_ApplyResources(t *testing.T) {
 		vpaCRDExists        bool
 		vpaEnabled          bool
 		vpaMaxAllowedMemory resource.Quantity
+		persistentQueue     bool
+		hostFS              bool
 	}{
 		{
 			name:           "Metric Agent",
@@ -97,6 +99,15 @@ func TestAgent_ApplyResources(t *testing.T) {
 			istioEnabled:   true,
 			goldenFilePath: "testdata/log-agent-istio.yaml",
 		},
+		{
+			name: "Metric Agent with host filesystem",
+			sut:  NewMetricAgentApplierDeleter(globals, collectorImage, priorityClassName),
+			collectorEnvVars: map[string][]byte{
+				"DUMMY_ENV_VAR": []byte("foo"),
+			},
+			hostFS:         true,
+			goldenFilePath: "testdata/metric-agent-hostfs.yaml",
+		},
 		{
 			name: "Log Agent with FIPS mode enabled",
 			sut:  NewLogAgentApplierDeleter(globalsWithFIPS, collectorImage, priorityClassName),
@@ -105,6 +116,15 @@ func TestAgent_ApplyResources(t *testing.T) {
 			},
 			goldenFilePath: "testdata/log-agent-fips-enabled.yaml",
 		},
+		{
+			name: "Log Agent with persistent queue",
+			sut:  NewLogAgentApplierDeleter(globals, collectorImage, priorityClassName),
+			collectorEnvVars: map[string][]byte{
+				"DUMMY_ENV_VAR": []byte("foo"),
+			},
+			persistentQueue: true,
+			goldenFilePath:  "testdata/log-agent-persistent-queue.yaml",
+		},
 		{
 			name: "Log Agent with VPA",
 			sut:  NewLogAgentApplierDeleter(globals, collectorImage, priorityClassName),
@@ -136,13 +156,15 @@ func TestAgent_ApplyResources(t *testing.T) {
 
 		t.Run(tt.name, func(t *testing.T) {
 			err := tt.sut.ApplyResources(t.Context(), fakeClient, AgentApplyOptions{
-				IstioEnabled:        tt.istioEnabled,
-				CollectorConfigYAML: "dummy",
-				CollectorEnvVars:    tt.collectorEnvVars,
-				BackendPorts:        tt.backendPorts,
-				VpaCRDExists:        tt.vpaCRDExists,
-				VpaEnabled:          tt.vpaEnabled,
-				VPAMaxAllowedMemory: tt.vpaMaxAllowedMemory,
+				IstioEnabled:           tt.istioEnabled,
+				CollectorConfigYAML:    "dummy",
+				CollectorEnvVars:       tt.collectorEnvVars,
+				BackendPorts:           tt.backendPorts,
+				VpaCRDExists:           tt.vpaCRDExists,
+				VpaEnabled:             tt.vpaEnabled,
+				VPAMaxAllowedMemory:    tt.vpaMaxAllowedMemory,
+				PersistentQueueEnabled: tt.persistentQueue,
+				HostFSEnabled:          tt.hostFS,
 			})
 			require.NoError(t, err)
 
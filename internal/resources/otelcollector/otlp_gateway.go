@@ -119,7 +119,7 @@ func (o *OTLPGatewayApplierDeleter) ApplyResources(ctx context.Context, c client
 
 	configChecksum := configchecksum.Calculate([]corev1.ConfigMap{*configMap}, []corev1.Secret{*secret})
 
-	networkPolicies := makeGatewayNetworkPolicies(name, opts.IstioEnabled)
+	networkPolicies := makeGatewayNetworkPolicies(name, opts.IstioEnabled, opts.PrometheusExpositionEnabled, opts.TraceSamplingEnabled)
 
 	for _, np := range networkPolicies {
 		if err := k8sutils.CreateOrUpdateNetworkPolicy(ctx, labelerClient, np); err != nil {
@@ -139,6 +139,14 @@ func (o *OTLPGatewayApplierDeleter) ApplyResources(ctx context.Context, c client
 		return fmt.Errorf("failed to create otlp service: %w", err)
 	}
 
+	if err := o.applyTraceSampling(ctx, c, labelerClient, name, opts); err != nil {
+		return err
+	}
+
+	if err := o.applyPrometheusExposition(ctx, c, labelerClient, name, opts); err != nil {
+		return err
+	}
+
 	// Create the legacy services for backward compatibility
 	// These services use the old names but point to the new DaemonSet
 	legacyLogService := o.makeLegacyOTLPService(names.OTLPLogsService)
@@ -222,6 +230,16 @@ func (o *OTLPGatewayApplierDeleter) DeleteResources(ctx context.Context, c clien
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete otlp service: %w", err))
 	}
 
+	traceSamplingService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.OTLPGatewayTraceSamplingService, Namespace: o.globals.TargetNamespace()}}
+	if err := k8sutils.DeleteObject(ctx, c, &traceSamplingService); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete trace sampling service: %w", err))
+	}
+
+	prometheusService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.OTLPGatewayPrometheusService, Namespace: o.globals.TargetNamespace()}}
+	if err := k8sutils.DeleteObject(ctx, c, &prometheusService); err != nil {
+		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete prometheus service: %w", err))
+	}
+
 	legacyLogService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.OTLPLogsService, Namespace: o.globals.TargetNamespace()}}
 	if err := k8sutils.DeleteObject(ctx, c, &legacyLogService); err != nil {
 		allErrors = errors.Join(allErrors, fmt.Errorf("failed to delete legacy log otlp service: %w", err))
@@ -285,6 +303,54 @@ func (o *OTLPGatewayApplierDeleter) applyVPA(ctx context.Context, c client.Clien
 	return nil
 }
 
+// applyTraceSampling creates the headless Service and NetworkPolicy for load-balancing spans between the gateway instances
+// if any pipeline uses tail sampling, and deletes them otherwise.
+func (o *OTLPGatewayApplierDeleter) applyTraceSampling(ctx context.Context, c client.Client, labelerClient client.Client, name types.NamespacedName, opts GatewayApplyOptions) error {
+	if opts.TraceSamplingEnabled {
+		if err := k8sutils.CreateOrUpdateService(ctx, labelerClient, o.makeTraceSamplingService()); err != nil {
+			return fmt.Errorf("failed to create trace sampling service: %w", err)
+		}
+
+		return nil
+	}
+
+	traceSamplingService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.OTLPGatewayTraceSamplingService, Namespace: name.Namespace}}
+	if err := k8sutils.DeleteObject(ctx, c, traceSamplingService); err != nil {
+		return fmt.Errorf("failed to delete trace sampling service: %w", err)
+	}
+
+	traceSamplingNetworkPolicy := makeTraceSamplingNetworkPolicy(name)
+	if err := k8sutils.DeleteObject(ctx, c, traceSamplingNetworkPolicy); err != nil {
+		return fmt.Errorf("failed to delete trace sampling network policy: %w", err)
+	}
+
+	return nil
+}
+
+// applyPrometheusExposition creates the Service and NetworkPolicy for scraping the Prometheus exporter if any pipeline has a Prometheus output,
+// and deletes them otherwise.
+func (o *OTLPGatewayApplierDeleter) applyPrometheusExposition(ctx context.Context, c client.Client, labelerClient client.Client, name types.NamespacedName, opts GatewayApplyOptions) error {
+	if opts.PrometheusExpositionEnabled {
+		if err := k8sutils.CreateOrUpdateService(ctx, labelerClient, o.makePrometheusService()); err != nil {
+			return fmt.Errorf("failed to create prometheus service: %w", err)
+		}
+
+		return nil
+	}
+
+	prometheusService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: names.OTLPGatewayPrometheusService, Namespace: name.Namespace}}
+	if err := k8sutils.DeleteObject(ctx, c, prometheusService); err != nil {
+		return fmt.Errorf("failed to delete prometheus service: %w", err)
+	}
+
+	prometheusNetworkPolicy := makePrometheusNetworkPolicy(name)
+	if err := k8sutils.DeleteObject(ctx, c, prometheusNetworkPolicy); err != nil {
+		return fmt.Errorf("failed to delete prometheus network policy: %w", err)
+	}
+
+	return nil
+}
+
 func (o *OTLPGatewayApplierDeleter) makeDestinationRule(name string) *istionetworkingclientv1.DestinationRule {
 	return &istionetworkingclientv1.DestinationRule{
 		ObjectMeta: metav1.ObjectMeta{
@@ -343,6 +409,52 @@ func (o *OTLPGatewayApplierDeleter) makeOTLPService() *corev1.Service {
 	return service
 }
 
+// makeTraceSamplingService creates a headless service, which is resolved by the load-balancing exporter to route spans of the same trace to the same gateway instance
+func (o *OTLPGatewayApplierDeleter) makeTraceSamplingService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayTraceSamplingService,
+			Namespace: o.globals.TargetNamespace(),
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "grpc-trace-sampling",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       ports.OTLPTraceSampling,
+					TargetPort: intstr.FromInt32(ports.OTLPTraceSampling),
+				},
+			},
+			Selector:  commonresources.DefaultSelector(o.baseName),
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: corev1.ClusterIPNone,
+		},
+	}
+}
+
+// makePrometheusService creates a service for scraping the metrics of pipelines with a Prometheus output.
+// Every gateway instance only exposes the metrics it received itself, so each instance must be scraped, for example, by discovering the endpoints of the service.
+func (o *OTLPGatewayApplierDeleter) makePrometheusService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      names.OTLPGatewayPrometheusService,
+			Namespace: o.globals.TargetNamespace(),
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http-prometheus",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       ports.PrometheusExposition,
+					TargetPort: intstr.FromInt32(ports.PrometheusExposition),
+				},
+			},
+			Selector: commonresources.DefaultSelector(o.baseName),
+			Type:     corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
 // makeLegacyOTLPService creates a service with a legacy name that points to the unified OTLP Gateway
 func (o *OTLPGatewayApplierDeleter) makeLegacyOTLPService(legacyServiceName string) *corev1.Service {
 	return &corev1.Service{
@@ -403,6 +515,11 @@ func (o *OTLPGatewayApplierDeleter) makeGatewayPodSpec(opts GatewayApplyOptions)
 		commonresources.WithClusterTrustBundleVolume(o.globals.ClusterTrustBundleName()),
 	)
 
+	if opts.PersistentQueueEnabled {
+		podOptions = append(podOptions, commonresources.WithVolumes([]corev1.Volume{makeSendingQueueVolume()}))
+		containerOpts = append(containerOpts, commonresources.WithVolumeMounts([]corev1.VolumeMount{makeSendingQueueVolumeMount()}))
+	}
+
 	return makePodSpec(
 		o.baseName,
 		o.image,
@@ -493,6 +610,12 @@ type GatewayApplyOptions struct {
 	VpaCRDExists                   bool
 	VpaEnabled                     bool
 	VPAMaxAllowedMemory            resource.Quantity
+	// PersistentQueueEnabled mounts the volume for persistent sending queues if at least one output uses one
+	PersistentQueueEnabled bool
+	// TraceSamplingEnabled creates the Service and NetworkPolicy for load-balancing spans if at least one pipeline uses tail sampling
+	TraceSamplingEnabled bool
+	// PrometheusExpositionEnabled creates the Service and NetworkPolicy for scraping if at least one pipeline has a Prometheus output
+	PrometheusExpositionEnabled bool
 }
 
 func makePodAffinity(labels map[string]string) corev1.Affinity {
@@ -522,7 +645,7 @@ func makePodAffinity(labels map[string]string) corev1.Affinity {
 	}
 }
 
-func makeGatewayNetworkPolicies(name types.NamespacedName, istioEnabled bool) []*networkingv1.NetworkPolicy {
+func makeGatewayNetworkPolicies(name types.NamespacedName, istioEnabled, prometheusExpositionEnabled, traceSamplingEnabled bool) []*networkingv1.NetworkPolicy {
 	var (
 		otlpPorts    = gatewayIngressOTLPPorts()
 		metricsPorts = gatewayIngressMetricsPorts(istioEnabled)
@@ -547,7 +670,37 @@ func makeGatewayNetworkPolicies(name types.NamespacedName, istioEnabled bool) []
 		commonresources.WithEgressToAny(),
 	)
 
-	return []*networkingv1.NetworkPolicy{metricsNetworkPolicy, gatewayNetworkPolicies}
+	networkPolicies := []*networkingv1.NetworkPolicy{metricsNetworkPolicy, gatewayNetworkPolicies}
+
+	if traceSamplingEnabled {
+		networkPolicies = append(networkPolicies, makeTraceSamplingNetworkPolicy(name))
+	}
+
+	if prometheusExpositionEnabled {
+		networkPolicies = append(networkPolicies, makePrometheusNetworkPolicy(name))
+	}
+
+	return networkPolicies
+}
+
+// makeTraceSamplingNetworkPolicy allows the gateway instances to load-balance the spans of sampled trace pipelines between each other
+func makeTraceSamplingNetworkPolicy(name types.NamespacedName) *networkingv1.NetworkPolicy {
+	return commonresources.MakeNetworkPolicy(
+		name,
+		commonresources.DefaultSelector(name.Name),
+		commonresources.WithNameSuffix("trace-sampling"),
+		commonresources.WithIngressFromPods(commonresources.DefaultSelector(name.Name), []int32{ports.OTLPTraceSampling}),
+	)
+}
+
+// makePrometheusNetworkPolicy allows any Prometheus server to scrape the metrics of pipelines with a Prometheus output
+func makePrometheusNetworkPolicy(name types.NamespacedName) *networkingv1.NetworkPolicy {
+	return commonresources.MakeNetworkPolicy(
+		name,
+		commonresources.DefaultSelector(name.Name),
+		commonresources.WithNameSuffix("prometheus"),
+		commonresources.WithIngressFromAny(ports.PrometheusExposition),
+	)
 }
 
 func gatewayIngressOTLPPorts() []int32 {
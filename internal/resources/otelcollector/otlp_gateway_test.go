@@ -56,6 +56,8 @@ func TestOTLPGateway_ApplyResources(t *testing.T) {
 		vpaMaxAllowedMemory            resource.Quantity
 		goldenFilePath                 string
 		resourceRequirementsMultiplier int
+		traceSamplingEnabled           bool
+		prometheusExpositionEnabled    bool
 	}{
 		{
 			name:           "OTLP Gateway",
@@ -98,6 +100,18 @@ func TestOTLPGateway_ApplyResources(t *testing.T) {
 			vpaMaxAllowedMemory:            resource.MustParse("1Gi"),
 			resourceRequirementsMultiplier: 3,
 		},
+		{
+			name:                 "OTLP Gateway with trace sampling",
+			sut:                  NewOTLPGatewayApplierDeleter(globals, image, priorityClassName),
+			goldenFilePath:       "testdata/otlp-gateway-trace-sampling.yaml",
+			traceSamplingEnabled: true,
+		},
+		{
+			name:                        "OTLP Gateway with Prometheus exposition",
+			sut:                         NewOTLPGatewayApplierDeleter(globals, image, priorityClassName),
+			goldenFilePath:              "testdata/otlp-gateway-prometheus-exposition.yaml",
+			prometheusExpositionEnabled: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -130,6 +144,8 @@ func TestOTLPGateway_ApplyResources(t *testing.T) {
 				VpaEnabled:                     tt.vpaEnabled,
 				VPAMaxAllowedMemory:            tt.vpaMaxAllowedMemory,
 				ResourceRequirementsMultiplier: tt.resourceRequirementsMultiplier,
+				TraceSamplingEnabled:           tt.traceSamplingEnabled,
+				PrometheusExpositionEnabled:    tt.prometheusExpositionEnabled,
 			})
 			require.NoError(t, err)
 
@@ -161,9 +177,11 @@ func TestOTLPGateway_DeleteResources(t *testing.T) {
 	}
 
 	tests := []struct {
-		name         string
-		sut          gatewayApplierDeleter
-		istioEnabled bool
+		name                        string
+		sut                         gatewayApplierDeleter
+		istioEnabled                bool
+		traceSamplingEnabled        bool
+		prometheusExpositionEnabled bool
 	}{
 
 		{
@@ -175,6 +193,16 @@ func TestOTLPGateway_DeleteResources(t *testing.T) {
 			sut:          NewOTLPGatewayApplierDeleter(globals, image, priorityClassName),
 			istioEnabled: true,
 		},
+		{
+			name:                 "OTLP Gateway with trace sampling",
+			sut:                  NewOTLPGatewayApplierDeleter(globals, image, priorityClassName),
+			traceSamplingEnabled: true,
+		},
+		{
+			name:                        "OTLP Gateway with Prometheus exposition",
+			sut:                         NewOTLPGatewayApplierDeleter(globals, image, priorityClassName),
+			prometheusExpositionEnabled: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -195,9 +223,11 @@ func TestOTLPGateway_DeleteResources(t *testing.T) {
 			}).Build()
 
 			err := tt.sut.ApplyResources(t.Context(), fakeClient, GatewayApplyOptions{
-				IstioEnabled: tt.istioEnabled,
-				VpaCRDExists: true,
-				VpaEnabled:   true,
+				IstioEnabled:                tt.istioEnabled,
+				VpaCRDExists:                true,
+				VpaEnabled:                  true,
+				TraceSamplingEnabled:        tt.traceSamplingEnabled,
+				PrometheusExpositionEnabled: tt.prometheusExpositionEnabled,
 			})
 			require.NoError(t, err)
 
@@ -128,9 +128,9 @@ func makeOTLPGatewayRBAC(namespace string) rbac {
 	return *newRBAC(
 		types.NamespacedName{Name: names.OTLPGateway, Namespace: namespace},
 		commonresources.LabelValueK8sComponentGateway,
-		withClusterRole(withK8sAttributeRules(), withKymaStatsRules()),
+		withClusterRole(withK8sAttributeRules(), withKymaStatsRules(), withK8sEventsRules()),
 		withClusterRoleBinding(),
-		withRole(withLeaderElectionRules()),
+		withRole(withLeaderElectionRules(), withLoadBalancingResolverRules()),
 		withRoleBinding(),
 	)
 }
@@ -150,6 +150,18 @@ func withLeaderElectionRules() RoleOption {
 	}
 }
 
+// withLoadBalancingResolverRules returns a role option for the k8s resolver of the load-balancing exporter, which discovers gateway instances in its own namespace
+func withLoadBalancingResolverRules() RoleOption {
+	return func(r *rbacv1.Role) {
+		loadBalancingResolverRules := []rbacv1.PolicyRule{{
+			APIGroups: []string{"discovery.k8s.io"},
+			Resources: []string{"endpointslices"},
+			Verbs:     []string{"get", "list", "watch"},
+		}}
+		r.Rules = append(r.Rules, loadBalancingResolverRules...)
+	}
+}
+
 type ClusterRoleOption func(*rbacv1.ClusterRole)
 
 func withK8sClusterRules() ClusterRoleOption {
@@ -232,6 +244,19 @@ func withK8sAttributeRules() ClusterRoleOption {
 	}
 }
 
+func withK8sEventsRules() ClusterRoleOption {
+	// policy rules needed for the k8seventsreceiver component
+	k8sEventsRules := []rbacv1.PolicyRule{{
+		APIGroups: []string{""},
+		Resources: []string{"events"},
+		Verbs:     []string{"get", "list", "watch"},
+	}}
+
+	return func(cr *rbacv1.ClusterRole) {
+		cr.Rules = append(cr.Rules, k8sEventsRules...)
+	}
+}
+
 func withKymaStatsRules() ClusterRoleOption {
 	// policy rules needed for the kymastatsreceiver component
 	kymaStatsRules := []rbacv1.PolicyRule{{
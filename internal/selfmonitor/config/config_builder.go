@@ -104,9 +104,11 @@ func makeScrapeConfig(scrapeNamespace string) []ScrapeConfig {
 				// For Fluent Bit metrics, the pipeline_name is based on the name label. Note that a regex group matching Kubernetes resource names (alphanumerical chars and hyphens) is used to extract the pipeline name.
 				// It allows to filter out timeseries with technical names (storage_backend.0, tail.0, etc.)
 				// For Fluent Bit metrics, the pipeline_type is always logpipeline.
-				// For OTel Collector metrics, the exporter label has the format [otlp_grpc|otlp_http]/<signaltype>pipeline-<pipeline_name>.
+				// For OTel Collector metrics, the exporter label has the format <exporter_type>/<signaltype>pipeline-<pipeline_name>, for example, otlp_grpc/metricpipeline-<pipeline_name> or prometheusremotewrite/metricpipeline-<pipeline_name>.
 				// The pipeline_type label captures the <signaltype>pipeline part (e.g. metricpipeline, tracepipeline, logpipeline).
 				// The pipeline_name label captures the bare pipeline name, with the <signaltype>pipeline- prefix stripped.
+				// Exporters of additional outputs have the format [otlp_grpc|otlp_http]/<signaltype>pipeline-<pipeline_name>_<output_name>.
+				// The output_name label captures the output name and is not set for the primary output.
 				{
 					SourceLabels: []string{"__name__", "name"},
 					Action:       Replace,
@@ -123,9 +125,15 @@ func makeScrapeConfig(scrapeNamespace string) []ScrapeConfig {
 				{
 					SourceLabels: []string{"__name__", "exporter"},
 					Action:       Replace,
-					Regex:        `otelcol_.+;.+/(?:(?:metric|trace|log)pipeline-)?([a-zA-Z0-9-]+)`,
+					Regex:        `otelcol_.+;.+/(?:(?:metric|trace|log)pipeline-)?([a-zA-Z0-9-]+)(?:_[a-zA-Z0-9-]+)?`,
 					TargetLabel:  "pipeline_name",
 				},
+				{
+					SourceLabels: []string{"__name__", "exporter"},
+					Action:       Replace,
+					Regex:        `otelcol_.+;.+/(?:metric|trace|log)pipeline-[a-zA-Z0-9-]+_([a-zA-Z0-9-]+)`,
+					TargetLabel:  "output_name",
+				},
 				{
 					SourceLabels: []string{"__name__", "exporter"},
 					Action:       Replace,
@@ -155,12 +163,19 @@ func scrapableMetricsRegex() string {
 		otelExporterSendFailed,
 		otelExporterEnqueueFailed,
 		otelReceiverRefused,
+		otelExporterPrometheusRemoteWriteFailedTranslations,
 	}
 
 	for i := range otelCollectorMetrics {
 		otelCollectorMetrics[i] += "_.*"
 	}
 
+	otelCollectorMetrics = append(otelCollectorMetrics,
+		otelExporterQueueSize,
+		otelExporterQueueCapacity,
+		promTargetScrapesExceededSampleLimit,
+	)
+
 	return strings.Join(append(fluentBitMetrics,
 		otelCollectorMetrics...), "|")
 }
@@ -20,6 +20,12 @@ func selectService(serviceName string) labelSelector {
 	}
 }
 
+func selectServiceAndPipelineType(serviceName, pipelineType string) labelSelector {
+	return func(metric string) string {
+		return fmt.Sprintf("%s{%s=\"%s\",%s=\"%s\"}", metric, labelService, serviceName, labelPipelineType, pipelineType)
+	}
+}
+
 func instant(metric string, selectors ...labelSelector) *exprBuilder {
 	for _, s := range selectors {
 		metric = s(metric)
@@ -54,6 +60,11 @@ func (eb *exprBuilder) maxBy(labels ...string) *exprBuilder {
 	return eb
 }
 
+func (eb *exprBuilder) divideBy(expr string) *exprBuilder {
+	eb.expr = fmt.Sprintf("%s / %s", eb.expr, expr)
+	return eb
+}
+
 func (eb *exprBuilder) greaterThan(value float64) *exprBuilder {
 	eb.expr = fmt.Sprintf("%s > %s", eb.expr, strconv.FormatFloat(value, 'f', -1, 64))
 	return eb
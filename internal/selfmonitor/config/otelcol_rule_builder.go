@@ -12,12 +12,33 @@ const (
 	otelExporterSendFailed    = "otelcol_exporter_send_failed"
 	otelExporterEnqueueFailed = "otelcol_exporter_enqueue_failed"
 	otelReceiverRefused       = "otelcol_receiver_refused"
+
+	// following metrics are specific to the Prometheus remote-write exporter
+	otelExporterPrometheusRemoteWriteFailedTranslations = "otelcol_exporter_prometheusremotewrite_failed_translations"
+
+	// following metrics are used without data type suffixes
+	otelExporterQueueSize     = "otelcol_exporter_queue_size"
+	otelExporterQueueCapacity = "otelcol_exporter_queue_capacity"
+
+	// Prometheus scrape metrics
+
+	// following metric is emitted by the Prometheus receiver for targets whose scrape exceeds the sample limit
+	promTargetScrapesExceededSampleLimit = "prometheus_target_scrapes_exceeded_sample_limit_total"
+
+	// queueUtilizationThreshold is the ratio of queue size to queue capacity, above which the buffer is considered to be filling up
+	queueUtilizationThreshold = 0.8
 )
 
 type otelCollectorRuleBuilder struct {
-	serviceName string
-	dataType    string
-	namePrefix  string
+	serviceName  string
+	dataType     string
+	namePrefix   string
+	pipelineType string
+	// prometheusRemoteWrite specifies whether the exporters can be Prometheus remote-write exporters.
+	// They drop metrics that fail the translation to time series without counting them as send failures.
+	prometheusRemoteWrite bool
+	// sampleLimit specifies whether the agents scrape Prometheus targets, which are rejected entirely if they exceed the sample limit.
+	sampleLimit bool
 }
 
 func (rb otelCollectorRuleBuilder) gatewayRules() []Rule {
@@ -25,20 +46,28 @@ func (rb otelCollectorRuleBuilder) gatewayRules() []Rule {
 		rb.makeRule(RuleNameGatewayAllDataDropped, rb.allDataDroppedExpr()),
 		rb.makeRule(RuleNameGatewaySomeDataDropped, rb.someDataDroppedExpr()),
 		rb.makeRule(RuleNameGatewayThrottling, rb.throttlingExpr()),
+		rb.makeRule(RuleNameGatewayBufferFillingUp, rb.bufferFillingUpExpr()),
 	}
 }
 
 func (rb otelCollectorRuleBuilder) agentRules() []Rule {
-	return []Rule{
+	rules := []Rule{
 		rb.makeRule(RuleNameAgentAllDataDropped, rb.allDataDroppedExpr()),
 		rb.makeRule(RuleNameAgentSomeDataDropped, rb.someDataDroppedExpr()),
+		rb.makeRule(RuleNameAgentBufferFillingUp, rb.bufferFillingUpExpr()),
+	}
+
+	if rb.sampleLimit {
+		rules = append(rules, rb.makeRule(RuleNameAgentSampleLimitExceeded, rb.sampleLimitExceededExpr()))
 	}
+
+	return rules
 }
 
 // Checks if all data is dropped due to a full buffer or exporter issues, with nothing successfully sent.
 func (rb otelCollectorRuleBuilder) allDataDroppedExpr() string {
 	return unless(
-		or(rb.exporterEnqueueFailedExpr(), rb.exporterDroppedExpr()),
+		or(rb.dataDroppedExprs()...),
 		rb.exporterSentExpr(),
 	)
 }
@@ -46,17 +75,27 @@ func (rb otelCollectorRuleBuilder) allDataDroppedExpr() string {
 // Checks if some data is dropped while some is still successfully sent.
 func (rb otelCollectorRuleBuilder) someDataDroppedExpr() string {
 	return and(
-		or(rb.exporterEnqueueFailedExpr(), rb.exporterDroppedExpr()),
+		or(rb.dataDroppedExprs()...),
 		rb.exporterSentExpr(),
 	)
 }
 
+// Returns the expressions that check for the different causes of data loss.
+func (rb otelCollectorRuleBuilder) dataDroppedExprs() []string {
+	exprs := []string{rb.exporterEnqueueFailedExpr(), rb.exporterDroppedExpr()}
+	if rb.prometheusRemoteWrite {
+		exprs = append(exprs, rb.exporterTranslationFailedExpr())
+	}
+
+	return exprs
+}
+
 // Check if the exporter drop rate is greater than 0.
 func (rb otelCollectorRuleBuilder) exporterSentExpr() string {
 	metricName := rb.appendDataType(otelExporterSent)
 
 	return rate(metricName, selectService(rb.serviceName)).
-		sumBy(labelPipelineName).
+		sumBy(labelPipelineName, labelOutputName).
 		greaterThan(0).
 		build()
 }
@@ -66,7 +105,7 @@ func (rb otelCollectorRuleBuilder) exporterDroppedExpr() string {
 	metricName := rb.appendDataType(otelExporterSendFailed)
 
 	return rate(metricName, selectService(rb.serviceName)).
-		sumBy(labelPipelineName).
+		sumBy(labelPipelineName, labelOutputName).
 		greaterThan(0).
 		build()
 }
@@ -76,7 +115,17 @@ func (rb otelCollectorRuleBuilder) exporterEnqueueFailedExpr() string {
 	metricName := rb.appendDataType(otelExporterEnqueueFailed)
 
 	return rate(metricName, selectService(rb.serviceName)).
-		sumBy(labelPipelineName).
+		sumBy(labelPipelineName, labelOutputName).
+		greaterThan(0).
+		build()
+}
+
+// Check if the rate of metrics failing the translation to Prometheus time series is greater than 0.
+func (rb otelCollectorRuleBuilder) exporterTranslationFailedExpr() string {
+	metricName := otelExporterPrometheusRemoteWriteFailedTranslations + "_total"
+
+	return rate(metricName, selectService(rb.serviceName)).
+		sumBy(labelPipelineName, labelOutputName).
 		greaterThan(0).
 		build()
 }
@@ -91,6 +140,27 @@ func (rb otelCollectorRuleBuilder) throttlingExpr() string {
 		build()
 }
 
+// Check if the exporter sending queue is nearing its capacity.
+// The queue metrics have no data type suffix, so the pipeline type is used to select the exporters of the respective signal.
+func (rb otelCollectorRuleBuilder) bufferFillingUpExpr() string {
+	selector := selectServiceAndPipelineType(rb.serviceName, rb.pipelineType)
+
+	return instant(otelExporterQueueSize, selector).
+		divideBy(instant(otelExporterQueueCapacity, selector).build()).
+		maxBy(labelPipelineName, labelOutputName).
+		greaterThan(queueUtilizationThreshold).
+		build()
+}
+
+// Check if the rate of scrapes exceeding the sample limit is greater than 0.
+// The scrape metrics have no pipeline label, since the Prometheus receivers are shared by all pipelines with the Prometheus input.
+func (rb otelCollectorRuleBuilder) sampleLimitExceededExpr() string {
+	return rate(promTargetScrapesExceededSampleLimit, selectService(rb.serviceName)).
+		sumBy(labelReceiver).
+		greaterThan(0).
+		build()
+}
+
 func (rb otelCollectorRuleBuilder) appendDataType(baseMetricName string) string {
 	return fmt.Sprintf("%s_%s", baseMetricName, rb.dataType)
 }
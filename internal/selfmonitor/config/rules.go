@@ -16,11 +16,15 @@ const (
 	RuleNameGatewayAllDataDropped  = "GatewayAllDataDropped"
 	RuleNameGatewaySomeDataDropped = "GatewaySomeDataDropped"
 	RuleNameGatewayThrottling      = "GatewayThrottling"
+	RuleNameGatewayBufferFillingUp = "GatewayBufferFillingUp"
 
-	// OTel Collector rule names for agents. Note that the actual full names will be prefixed with Log
+	// OTel Collector rule names for agents. Note that the actual full names will be prefixed with Metric or Log
 
 	RuleNameAgentAllDataDropped  = "AgentAllDataDropped"
 	RuleNameAgentSomeDataDropped = "AgentSomeDataDropped"
+	RuleNameAgentBufferFillingUp = "AgentBufferFillingUp"
+	// RuleNameAgentSampleLimitExceeded is agent-wide: its alert has no pipeline_name label and is matched by all metric pipelines
+	RuleNameAgentSampleLimitExceeded = "AgentSampleLimitExceeded"
 
 	// Fluent Bit rule names. Note that the actual full names will be prefixed with Log
 
@@ -37,7 +41,8 @@ const (
 
 	// OTel Collector rule labels
 
-	labelReceiver = "receiver"
+	labelReceiver   = "receiver"
+	labelOutputName = "output_name"
 )
 
 // RuleGroups is a set of rule groups that are typically exposed in a file.
@@ -73,39 +78,47 @@ func MakeRules() RuleGroups {
 
 	// OTLP Gateway - Metric pipelines
 	metricGatewayRuleBuilder := otelCollectorRuleBuilder{
-		dataType:    ruleDataType(typeMetricPipeline),
-		serviceName: names.OTLPGatewayMetricsService,
-		namePrefix:  ruleNamePrefix(typeMetricPipeline),
+		dataType:              ruleDataType(typeMetricPipeline),
+		serviceName:           names.OTLPGatewayMetricsService,
+		namePrefix:            ruleNamePrefix(typeMetricPipeline),
+		pipelineType:          pipelineComponentType(typeMetricPipeline),
+		prometheusRemoteWrite: true,
 	}
 	rules = append(rules, metricGatewayRuleBuilder.gatewayRules()...)
 
 	metricAgentRuleBuilder := otelCollectorRuleBuilder{
-		dataType:    ruleDataType(typeMetricPipeline),
-		serviceName: names.MetricAgentMetricsService,
-		namePrefix:  ruleNamePrefix(typeMetricPipeline),
+		dataType:              ruleDataType(typeMetricPipeline),
+		serviceName:           names.MetricAgentMetricsService,
+		namePrefix:            ruleNamePrefix(typeMetricPipeline),
+		pipelineType:          pipelineComponentType(typeMetricPipeline),
+		prometheusRemoteWrite: true,
+		sampleLimit:           true,
 	}
 	rules = append(rules, metricAgentRuleBuilder.agentRules()...)
 
 	// OTLP Gateway - Trace pipelines
 	traceGatewayRuleBuilder := otelCollectorRuleBuilder{
-		dataType:    ruleDataType(typeTracePipeline),
-		serviceName: names.OTLPGatewayMetricsService,
-		namePrefix:  ruleNamePrefix(typeTracePipeline),
+		dataType:     ruleDataType(typeTracePipeline),
+		serviceName:  names.OTLPGatewayMetricsService,
+		namePrefix:   ruleNamePrefix(typeTracePipeline),
+		pipelineType: pipelineComponentType(typeTracePipeline),
 	}
 	rules = append(rules, traceGatewayRuleBuilder.gatewayRules()...)
 
 	// OTLP Gateway - Log pipelines
 	logGatewayRuleBuilder := otelCollectorRuleBuilder{
-		dataType:    ruleDataType(typeLogPipeline),
-		serviceName: names.OTLPGatewayMetricsService,
-		namePrefix:  ruleNamePrefix(typeLogPipeline),
+		dataType:     ruleDataType(typeLogPipeline),
+		serviceName:  names.OTLPGatewayMetricsService,
+		namePrefix:   ruleNamePrefix(typeLogPipeline),
+		pipelineType: pipelineComponentType(typeLogPipeline),
 	}
 	rules = append(rules, logGatewayRuleBuilder.gatewayRules()...)
 
 	logAgentRuleBuilder := otelCollectorRuleBuilder{
-		dataType:    ruleDataType(typeLogPipeline),
-		serviceName: names.LogAgentMetricsService,
-		namePrefix:  ruleNamePrefix(typeLogPipeline),
+		dataType:     ruleDataType(typeLogPipeline),
+		serviceName:  names.LogAgentMetricsService,
+		namePrefix:   ruleNamePrefix(typeLogPipeline),
+		pipelineType: pipelineComponentType(typeLogPipeline),
 	}
 
 	rules = append(rules, logAgentRuleBuilder.agentRules()...)
@@ -188,6 +201,12 @@ func MatchesLogPipelineRule(labelSet map[string]string, unprefixedRuleName strin
 	return matchesRule(labelSet, unprefixedRuleName, pipelineName, typeLogPipeline)
 }
 
+// OutputName returns the name of the additional pipeline output that the given alert label set refers to.
+// Returns an empty string if the alert refers to the primary output or is not output-specific.
+func OutputName(labelSet map[string]string) string {
+	return labelSet[labelOutputName]
+}
+
 func matchesRule(labelSet map[string]string, unprefixedRuleName string, pipelineName string, t pipelineType) bool {
 	if !matchesRuleName(labelSet, unprefixedRuleName, t) {
 		return false
@@ -299,6 +299,18 @@ func TestMatchesTracePipelineRule(t *testing.T) {
 			pipelineName:       "testPipeline",
 			expectedResult:     true,
 		},
+		{
+			name: "rule name matches and alert refers to an additional output of the pipeline",
+			labelSet: map[string]string{
+				"alertname":     "TraceGatewayAllDataDropped",
+				"pipeline_name": "testPipeline",
+				"pipeline_type": "tracepipeline",
+				"output_name":   "archive",
+			},
+			unprefixedRuleName: "GatewayAllDataDropped",
+			pipelineName:       "testPipeline",
+			expectedResult:     true,
+		},
 		{
 			name: "rule name matches but pipeline_type is for a different signal (metricpipeline on trace check)",
 			labelSet: map[string]string{
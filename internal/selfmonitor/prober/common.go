@@ -7,6 +7,8 @@ import (
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	selfmonitorconfig "github.com/kyma-project/telemetry-manager/internal/selfmonitor/config"
 )
 
 type alertGetter interface {
@@ -19,6 +21,20 @@ type PipelineProbeResult struct {
 	Healthy         bool
 }
 
+// OutputProbeResults holds the probe results of the additional outputs of a pipeline, keyed by output name.
+// Only outputs with firing alerts are contained.
+type OutputProbeResults map[string]PipelineProbeResult
+
+// Get returns the probe result of the additional output with the given name.
+// Outputs without firing alerts are considered healthy.
+func (r OutputProbeResults) Get(outputName string) PipelineProbeResult {
+	if result, ok := r[outputName]; ok {
+		return result
+	}
+
+	return PipelineProbeResult{Healthy: true}
+}
+
 type matcherFunc func(alertLabels map[string]string, expectedRuleName string, expectedPipelineName string) bool
 
 func retrieveAlerts(ctx context.Context, getter alertGetter) ([]promv1.Alert, error) {
@@ -42,6 +58,43 @@ func isFiringWithMatcher(alerts []promv1.Alert, ruleName, pipelineName string, m
 	return false
 }
 
+// probeOutputs evaluates the data drop rules separately for every additional output of the pipeline.
+// Returns nil if no output-specific alert is firing.
+func probeOutputs(alerts []promv1.Alert, allDroppedRuleName, someDroppedRuleName, pipelineName string, mf matcherFunc) OutputProbeResults {
+	var results OutputProbeResults
+
+	for _, alert := range alerts {
+		if alert.State != promv1.AlertStateFiring {
+			continue
+		}
+
+		labels := toRawLabels(alert.Labels)
+
+		outputName := selfmonitorconfig.OutputName(labels)
+		if outputName == "" {
+			continue
+		}
+
+		allDropped := mf(labels, allDroppedRuleName, pipelineName)
+		someDropped := mf(labels, someDroppedRuleName, pipelineName)
+
+		if !allDropped && !someDropped {
+			continue
+		}
+
+		if results == nil {
+			results = make(OutputProbeResults)
+		}
+
+		result := results[outputName]
+		result.AllDataDropped = result.AllDataDropped || allDropped
+		result.SomeDataDropped = result.SomeDataDropped || someDropped
+		results[outputName] = result
+	}
+
+	return results
+}
+
 func toRawLabels(ls model.LabelSet) map[string]string {
 	rawLabels := make(map[string]string, len(ls))
 	for k, v := range ls {
@@ -18,6 +18,13 @@ type OTelAgentProber struct {
 
 type OTelAgentProbeResult struct {
 	PipelineProbeResult
+
+	BufferFillingUp bool
+	// SampleLimitExceeded is only reported for metric pipelines, whose Prometheus scrape targets are rejected if they exceed their sample limit
+	SampleLimitExceeded bool
+
+	// Outputs holds the probe results of the additional outputs of the pipeline.
+	Outputs OutputProbeResults
 }
 
 func NewOTelLogAgentProber(selfMonitorName types.NamespacedName) (*OTelAgentProber, error) {
@@ -44,7 +51,9 @@ func (p *OTelAgentProber) Probe(ctx context.Context, pipelineName string) (OTelA
 
 	allDropped := p.isFiring(alerts, selfmonitorconfig.RuleNameAgentAllDataDropped, pipelineName)
 	someDropped := p.isFiring(alerts, selfmonitorconfig.RuleNameAgentSomeDataDropped, pipelineName)
-	healthy := !allDropped && !someDropped
+	bufferFillingUp := p.isFiring(alerts, selfmonitorconfig.RuleNameAgentBufferFillingUp, pipelineName)
+	sampleLimitExceeded := p.isFiring(alerts, selfmonitorconfig.RuleNameAgentSampleLimitExceeded, pipelineName)
+	healthy := !allDropped && !someDropped && !bufferFillingUp && !sampleLimitExceeded
 
 	return OTelAgentProbeResult{
 		PipelineProbeResult: PipelineProbeResult{
@@ -52,6 +61,9 @@ func (p *OTelAgentProber) Probe(ctx context.Context, pipelineName string) (OTelA
 			SomeDataDropped: someDropped,
 			Healthy:         healthy,
 		},
+		BufferFillingUp:     bufferFillingUp,
+		SampleLimitExceeded: sampleLimitExceeded,
+		Outputs:             probeOutputs(alerts, selfmonitorconfig.RuleNameAgentAllDataDropped, selfmonitorconfig.RuleNameAgentSomeDataDropped, pipelineName, p.matcher),
 	}, nil
 }
 
@@ -206,6 +206,24 @@ func TestOTelLogAgentProber(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "buffer filling up alert firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "LogAgentBufferFillingUp",
+							"pipeline_name": "cls",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelAgentProbeResult{
+				BufferFillingUp: true,
+			},
+		},
 		{
 			name:         "healthy",
 			pipelineName: "cls",
@@ -247,3 +265,66 @@ func TestOTelLogAgentProber(t *testing.T) {
 		})
 	}
 }
+
+func TestOTelMetricAgentProber(t *testing.T) {
+	testCases := []struct {
+		name         string
+		alerts       promv1.AlertsResult
+		pipelineName string
+		expected     OTelAgentProbeResult
+	}{
+		{
+			name:         "sample limit exceeded alert firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname": "MetricAgentSampleLimitExceeded",
+							"receiver":  "prometheus/app-pods",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelAgentProbeResult{
+				SampleLimitExceeded: true,
+			},
+		},
+		{
+			name:         "sample limit exceeded alert of another pipeline type firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname": "LogAgentSampleLimitExceeded",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelAgentProbeResult{
+				PipelineProbeResult: PipelineProbeResult{
+					Healthy: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut, err := NewOTelMetricAgentProber(types.NamespacedName{Name: "test"})
+			require.NoError(t, err)
+
+			alertGetterMock := &mocks.AlertGetter{}
+			alertGetterMock.On("Alerts", mock.Anything).Return(tc.alerts, nil)
+
+			sut.getter = alertGetterMock
+
+			result, err := sut.Probe(t.Context(), tc.pipelineName)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -19,7 +19,17 @@ type OTelGatewayProber struct {
 type OTelGatewayProbeResult struct {
 	PipelineProbeResult
 
-	Throttling bool
+	Throttling      bool
+	BufferFillingUp bool
+
+	// Outputs holds the probe results of the additional outputs of the pipeline.
+	Outputs OutputProbeResults
+}
+
+// OnlyPrimaryOutputDropping returns true if data is dropped, but none of the named outputs of the pipeline (additional or failover outputs)
+// reports data drops. In that case, the drops originate from the exporter of the primary output.
+func (r OTelGatewayProbeResult) OnlyPrimaryOutputDropping() bool {
+	return (r.AllDataDropped || r.SomeDataDropped) && !r.Throttling && !r.BufferFillingUp && len(r.Outputs) == 0
 }
 
 func NewOTelMetricGatewayProber(selfMonitorName types.NamespacedName) (*OTelGatewayProber, error) {
@@ -60,7 +70,8 @@ func (p *OTelGatewayProber) Probe(ctx context.Context, pipelineName string) (OTe
 	allDropped := p.isFiring(alerts, selfmonitorconfig.RuleNameGatewayAllDataDropped, pipelineName)
 	someDropped := p.isFiring(alerts, selfmonitorconfig.RuleNameGatewaySomeDataDropped, pipelineName)
 	throttling := p.isFiring(alerts, selfmonitorconfig.RuleNameGatewayThrottling, pipelineName)
-	healthy := !allDropped && !someDropped && !throttling
+	bufferFillingUp := p.isFiring(alerts, selfmonitorconfig.RuleNameGatewayBufferFillingUp, pipelineName)
+	healthy := !allDropped && !someDropped && !throttling && !bufferFillingUp
 
 	return OTelGatewayProbeResult{
 		PipelineProbeResult: PipelineProbeResult{
@@ -68,7 +79,9 @@ func (p *OTelGatewayProber) Probe(ctx context.Context, pipelineName string) (OTe
 			SomeDataDropped: someDropped,
 			Healthy:         healthy,
 		},
-		Throttling: throttling,
+		Outputs:         probeOutputs(alerts, selfmonitorconfig.RuleNameGatewayAllDataDropped, selfmonitorconfig.RuleNameGatewaySomeDataDropped, pipelineName, p.matcher),
+		Throttling:      throttling,
+		BufferFillingUp: bufferFillingUp,
 	}, nil
 }
 
@@ -224,6 +224,50 @@ func TestOTelTraceGatewayProber(t *testing.T) {
 				Throttling: true,
 			},
 		},
+		{
+			name:         "buffer filling up alert firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "TraceGatewayBufferFillingUp",
+							"pipeline_name": "cls",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelGatewayProbeResult{
+				BufferFillingUp: true,
+			},
+		},
+		{
+			name:         "alert for additional output firing",
+			pipelineName: "cls",
+			alerts: promv1.AlertsResult{
+				Alerts: []promv1.Alert{
+					{
+						Labels: model.LabelSet{
+							"alertname":     "TraceGatewayAllDataDropped",
+							"pipeline_name": "cls",
+							"output_name":   "archive",
+						},
+						State: promv1.AlertStateFiring,
+					},
+				},
+			},
+			expected: OTelGatewayProbeResult{
+				PipelineProbeResult: PipelineProbeResult{
+					AllDataDropped: true,
+				},
+				Outputs: OutputProbeResults{
+					"archive": {
+						AllDataDropped: true,
+					},
+				},
+			},
+		},
 		{
 			name:         "healthy",
 			pipelineName: "cls",
@@ -19,13 +19,18 @@ const (
 )
 
 func PipelineMode(lp *telemetryv1beta1.LogPipeline) Mode {
-	if lp.Spec.Output.OTLP != nil {
+	if IsOTelOutputDefined(&lp.Spec.Output) {
 		return OTel
 	}
 
 	return FluentBit
 }
 
+// IsOTelOutputDefined returns true if the pipeline has an output of the OpenTelemetry-based stack (OTLP, Kafka, Loki, or Elasticsearch).
+func IsOTelOutputDefined(o *telemetryv1beta1.LogPipelineOutput) bool {
+	return o.OTLP != nil || o.Kafka != nil || o.Loki != nil || o.Elasticsearch != nil
+}
+
 func IsCustomOutputDefined(o *telemetryv1beta1.LogPipelineOutput) bool {
 	return o.FluentBitCustom != ""
 }
@@ -46,6 +51,15 @@ func IsRuntimeInputEnabled(i *telemetryv1beta1.LogPipelineInput) bool {
 	return i.Runtime != nil && ptr.Deref(i.Runtime.Enabled, true)
 }
 
+// IsOTLPInputEnabled returns true if the pipeline receives push-based OTLP logs, which is the default if the OTLP input is not configured.
+func IsOTLPInputEnabled(i *telemetryv1beta1.LogPipelineInput) bool {
+	return i.OTLP == nil || sharedtypesutils.IsOTLPInputEnabled(&i.OTLP.OTLPInput)
+}
+
+func IsEventsInputEnabled(i *telemetryv1beta1.LogPipelineInput) bool {
+	return i.Events != nil && ptr.Deref(i.Events.Enabled, false)
+}
+
 // ContainsCustomPlugin returns true if the pipeline contains any custom filters or outputs
 func ContainsCustomPlugin(lp *telemetryv1beta1.LogPipeline) bool {
 	return IsCustomOutputDefined(&lp.Spec.Output) || IsCustomFilterDefined(lp.Spec.FluentBitFilters)
@@ -79,7 +93,7 @@ func GetPipelinesForType(ctx context.Context, client client.Client, mode Mode) (
 }
 
 func GetOutputType(t *telemetryv1beta1.LogPipeline) Mode {
-	if t.Spec.Output.OTLP != nil {
+	if IsOTelOutputDefined(&t.Spec.Output) {
 		return OTel
 	}
 
@@ -3,6 +3,7 @@ package metricpipeline
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"slices"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/otelcollector/ports"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 	sharedtypesutils "github.com/kyma-project/telemetry-manager/internal/utils/sharedtypes"
 )
 
@@ -35,6 +37,10 @@ func IsPrometheusDiagnosticInputEnabled(input telemetryv1beta1.MetricPipelineInp
 	return input.Prometheus.DiagnosticMetrics != nil && input.Prometheus.DiagnosticMetrics.Enabled != nil && *input.Prometheus.DiagnosticMetrics.Enabled
 }
 
+func IsPrometheusMonitorsInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Prometheus.Monitors != nil && input.Prometheus.Monitors.Enabled != nil && *input.Prometheus.Monitors.Enabled
+}
+
 func IsIstioDiagnosticInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
 	return input.Istio.DiagnosticMetrics != nil && input.Istio.DiagnosticMetrics.Enabled != nil && *input.Istio.DiagnosticMetrics.Enabled
 }
@@ -111,33 +117,81 @@ func IsRuntimeJobInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
 	return *input.Runtime.Resources.Job.Enabled
 }
 
+func IsHostInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Host != nil && input.Host.Enabled != nil && *input.Host.Enabled
+}
+
+func IsHostCPUInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Host.Scrapers == nil || isHostScraperEnabled(input.Host.Scrapers.CPU)
+}
+
+func IsHostMemoryInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Host.Scrapers == nil || isHostScraperEnabled(input.Host.Scrapers.Memory)
+}
+
+func IsHostDiskInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Host.Scrapers == nil || isHostScraperEnabled(input.Host.Scrapers.Disk)
+}
+
+func IsHostFilesystemInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Host.Scrapers == nil || isHostScraperEnabled(input.Host.Scrapers.Filesystem)
+}
+
+func IsHostNetworkInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Host.Scrapers == nil || isHostScraperEnabled(input.Host.Scrapers.Network)
+}
+
+func IsHostLoadInputEnabled(input telemetryv1beta1.MetricPipelineInput) bool {
+	return input.Host.Scrapers == nil || isHostScraperEnabled(input.Host.Scrapers.Load)
+}
+
+// isHostScraperEnabled returns whether a host scraper is enabled. Host scrapers are enabled by default if the scraper or its Enabled field is nil.
+func isHostScraperEnabled(scraper *telemetryv1beta1.MetricPipelineHostInputScraper) bool {
+	return scraper == nil || scraper.Enabled == nil || *scraper.Enabled
+}
+
 func IsDeltaTemporality(output telemetryv1beta1.MetricPipelineOutput) bool {
-	return *output.OTLP.Temporality == telemetryv1beta1.TemporalityDelta
+	return output.OTLP != nil && output.OTLP.Temporality != nil && *output.OTLP.Temporality == telemetryv1beta1.TemporalityDelta
 }
 
-// OTLPOutputPorts returns the list of ports of the backends defined in all given MetricPipelines
+// OTLPOutputPorts returns the list of ports of the backends defined in all given MetricPipelines, including Kafka brokers
 func OTLPOutputPorts(ctx context.Context, c client.Reader, allPipelines []telemetryv1beta1.MetricPipeline) ([]string, error) {
 	backendPorts := []string{}
 
-	for _, pipeline := range allPipelines {
-		endpoint, err := sharedtypesutils.ResolveValue(ctx, c, pipeline.Spec.Output.OTLP.Endpoint)
-		if err != nil {
-			return nil, fmt.Errorf("failed to resolve the value of the OTLP output endpoint: %w", err)
+	for i := range allPipelines {
+		outputs := pipelines.MetricPipelineOutputs(&allPipelines[i])
+		for _, output := range pipelines.MetricPipelinePrometheusRemoteWriteOutputs(&allPipelines[i]) {
+			outputs = append(outputs, output.OTLPOutput())
 		}
 
-		port := extractPort(string(endpoint), pipeline.Spec.Output.OTLP.Protocol)
+		for _, output := range outputs {
+			endpoint, err := sharedtypesutils.ResolveValue(ctx, c, output.OTLP.Endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve the value of the OTLP output endpoint: %w", err)
+			}
+
+			port := extractPort(string(endpoint), output.OTLP.Protocol)
 
-		if port != "" {
-			backendPorts = append(backendPorts, port)
+			if port != "" {
+				backendPorts = append(backendPorts, port)
+			}
 		}
 
-		// List of ports needs to be sorted
-		// Otherwise, Metric Agent will continuously restart, because in each reconciliation we can have the ports list in a different order
-		slices.Sort(backendPorts)
-		// Remove duplication in ports in case multiple backends are defined with the same port
-		backendPorts = slices.Compact(backendPorts)
+		for _, output := range pipelines.MetricPipelineKafkaOutputs(&allPipelines[i]) {
+			for _, broker := range output.Kafka.Brokers {
+				if _, port, err := net.SplitHostPort(broker); err == nil && port != "" {
+					backendPorts = append(backendPorts, port)
+				}
+			}
+		}
 	}
 
+	// List of ports needs to be sorted
+	// Otherwise, Metric Agent will continuously restart, because in each reconciliation we can have the ports list in a different order
+	slices.Sort(backendPorts)
+	// Remove duplication in ports in case multiple backends are defined with the same port
+	backendPorts = slices.Compact(backendPorts)
+
 	return backendPorts, nil
 }
 
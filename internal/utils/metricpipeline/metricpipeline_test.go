@@ -26,6 +26,31 @@ func TestOTLPOutputPort(t *testing.T) {
 		require.Equal(t, []string{"4317", "4318", "9090"}, ports)
 	})
 
+	t.Run("metric pipeline with additional outputs", func(t *testing.T) {
+		metricPipelines := []telemetryv1beta1.MetricPipeline{
+			testutils.NewMetricPipelineBuilder().
+				WithRuntimeInput(true).
+				WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://sample.test.com:4317")).
+				WithAdditionalOTLPOutput("archive", testutils.OTLPEndpoint("https://archive.test.com:8443")).
+				Build(),
+		}
+
+		ports, err := OTLPOutputPorts(t.Context(), fakeClient, metricPipelines)
+		require.NoError(t, err)
+		require.Equal(t, []string{"4317", "8443"}, ports)
+	})
+
+	t.Run("metric pipeline with kafka output", func(t *testing.T) {
+		metricPipelines := []telemetryv1beta1.MetricPipeline{
+			testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://sample.test.com:4317")).Build(),
+			testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).WithKafkaOutput(testutils.KafkaBrokers("kafka-0.test.com:9092", "kafka-1.test.com:9093")).Build(),
+		}
+
+		ports, err := OTLPOutputPorts(t.Context(), fakeClient, metricPipelines)
+		require.NoError(t, err)
+		require.Equal(t, []string{"4317", "9092", "9093"}, ports)
+	})
+
 	t.Run("some metric pipelines have invalid endpoints", func(t *testing.T) {
 		metricPipelines := []telemetryv1beta1.MetricPipeline{
 			testutils.NewMetricPipelineBuilder().WithRuntimeInput(true).WithMetricPipelineOTLPOutput(testutils.OTLPEndpoint("https://sample.test.com:4317")).Build(),
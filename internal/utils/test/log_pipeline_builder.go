@@ -22,14 +22,22 @@ type LogPipelineBuilder struct {
 
 	httpOutput   *telemetryv1beta1.FluentBitHTTPOutput
 	otlpOutput   *telemetryv1beta1.OTLPOutput
+	kafkaOutput  *telemetryv1beta1.KafkaOutput
+	lokiOutput   *telemetryv1beta1.LokiOutput
+	esOutput     *telemetryv1beta1.ElasticsearchOutput
 	oauth2       *telemetryv1beta1.OAuth2Options
 	customOutput string
 
+	additionalOutputs []telemetryv1beta1.NamedOTLPOutput
+	failoverOutput    *telemetryv1beta1.OTLPOutput
+
 	fluentBitFilters []telemetryv1beta1.FluentBitFilter
 	files            []telemetryv1beta1.FluentBitFile
 	variables        []telemetryv1beta1.FluentBitVariable
 	transforms       []telemetryv1beta1.TransformSpec
+	redaction        *telemetryv1beta1.RedactionSpec
 	filters          []telemetryv1beta1.FilterSpec
+	deduplicate      *telemetryv1beta1.LogPipelineDeduplicate
 
 	statusConditions []metav1.Condition
 }
@@ -59,9 +67,11 @@ func BuildLogPipelineOTLPInput(opts ...NamespaceSelectorOptions) telemetryv1beta
 		Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
 			Enabled: new(false),
 		},
-		OTLP: &telemetryv1beta1.OTLPInput{
-			Enabled:    new(true),
-			Namespaces: &telemetryv1beta1.NamespaceSelector{},
+		OTLP: &telemetryv1beta1.LogPipelineOTLPInput{
+			OTLPInput: telemetryv1beta1.OTLPInput{
+				Enabled:    new(true),
+				Namespaces: &telemetryv1beta1.NamespaceSelector{},
+			},
 		},
 	}
 
@@ -206,6 +216,82 @@ func (b *LogPipelineBuilder) WithKeepOriginalBody(keep bool) *LogPipelineBuilder
 	return b
 }
 
+func (b *LogPipelineBuilder) WithMultiline(rules ...telemetryv1beta1.LogPipelineMultiline) *LogPipelineBuilder {
+	if b.input.Runtime == nil {
+		b.input.Runtime = &telemetryv1beta1.LogPipelineRuntimeInput{}
+	}
+
+	b.input.Runtime.Multiline = append(b.input.Runtime.Multiline, rules...)
+
+	return b
+}
+
+func (b *LogPipelineBuilder) WithEventsInput(enabled bool, opts ...NamespaceSelectorOptions) *LogPipelineBuilder {
+	b.input.Events = &telemetryv1beta1.LogPipelineEventsInput{
+		Enabled: new(enabled),
+	}
+
+	if len(opts) > 0 {
+		b.input.Events.Namespaces = &telemetryv1beta1.NamespaceSelector{}
+		for _, opt := range opts {
+			opt(b.input.Events.Namespaces)
+		}
+	}
+
+	return b
+}
+
+func (b *LogPipelineBuilder) WithEventsFilter(types []telemetryv1beta1.EventType, reasons []string) *LogPipelineBuilder {
+	if b.input.Events == nil {
+		b.input.Events = &telemetryv1beta1.LogPipelineEventsInput{}
+	}
+
+	b.input.Events.Types = types
+	b.input.Events.Reasons = reasons
+
+	return b
+}
+
+func (b *LogPipelineBuilder) WithParser(parser *telemetryv1beta1.LogPipelineParser) *LogPipelineBuilder {
+	if b.input.Runtime == nil {
+		b.input.Runtime = &telemetryv1beta1.LogPipelineRuntimeInput{}
+	}
+
+	b.input.Runtime.Parser = parser
+
+	return b
+}
+
+func (b *LogPipelineBuilder) WithMinSeverity(minSeverity *telemetryv1beta1.LogPipelineMinSeverity) *LogPipelineBuilder {
+	if b.input.Runtime == nil {
+		b.input.Runtime = &telemetryv1beta1.LogPipelineRuntimeInput{}
+	}
+
+	b.input.Runtime.MinSeverity = minSeverity
+
+	return b
+}
+
+func (b *LogPipelineBuilder) WithOTLPInputMinSeverity(minSeverity *telemetryv1beta1.LogPipelineMinSeverity) *LogPipelineBuilder {
+	if b.input.OTLP == nil {
+		b.input.OTLP = &telemetryv1beta1.LogPipelineOTLPInput{}
+	}
+
+	b.input.OTLP.MinSeverity = minSeverity
+
+	return b
+}
+
+func (b *LogPipelineBuilder) WithPodSelector(selector *telemetryv1beta1.LogPipelinePodSelector) *LogPipelineBuilder {
+	if b.input.Runtime == nil {
+		b.input.Runtime = &telemetryv1beta1.LogPipelineRuntimeInput{}
+	}
+
+	b.input.Runtime.Pods = selector
+
+	return b
+}
+
 func (b *LogPipelineBuilder) WithCustomFilter(filter string) *LogPipelineBuilder {
 	b.fluentBitFilters = append(b.fluentBitFilters, telemetryv1beta1.FluentBitFilter{Custom: filter})
 	return b
@@ -253,6 +339,71 @@ func (b *LogPipelineBuilder) WithOTLPOutput(opts ...OTLPOutputOption) *LogPipeli
 	return b
 }
 
+func (b *LogPipelineBuilder) WithKafkaOutput(opts ...KafkaOutputOption) *LogPipelineBuilder {
+	b.kafkaOutput = defaultKafkaOutput()
+	for _, opt := range opts {
+		opt(b.kafkaOutput)
+	}
+
+	return b
+}
+
+// WithLokiOutput sets a Loki output. The OTLP output options for endpoint, authentication, headers, and TLS apply to the Loki output as well.
+func (b *LogPipelineBuilder) WithLokiOutput(opts ...OTLPOutputOption) *LogPipelineBuilder {
+	output := telemetryv1beta1.OTLPOutput{
+		Endpoint: telemetryv1beta1.ValueType{Value: "http://localhost:3100"},
+	}
+
+	for _, opt := range opts {
+		opt(&output)
+	}
+
+	b.lokiOutput = &telemetryv1beta1.LokiOutput{
+		Endpoint:       output.Endpoint,
+		Authentication: output.Authentication,
+		Headers:        output.Headers,
+		TLS:            output.TLS,
+	}
+
+	return b
+}
+
+// WithElasticsearchOutput sets an Elasticsearch output writing to the given index. The OTLP output options for endpoint, authentication, headers, and TLS apply to the Elasticsearch output as well.
+func (b *LogPipelineBuilder) WithElasticsearchOutput(index, indexFromResourceAttribute string, opts ...OTLPOutputOption) *LogPipelineBuilder {
+	output := telemetryv1beta1.OTLPOutput{
+		Endpoint: telemetryv1beta1.ValueType{Value: "http://localhost:9200"},
+	}
+
+	for _, opt := range opts {
+		opt(&output)
+	}
+
+	b.esOutput = &telemetryv1beta1.ElasticsearchOutput{
+		Endpoint:                   output.Endpoint,
+		Authentication:             output.Authentication,
+		Headers:                    output.Headers,
+		TLS:                        output.TLS,
+		Index:                      index,
+		IndexFromResourceAttribute: indexFromResourceAttribute,
+	}
+
+	return b
+}
+
+func (b *LogPipelineBuilder) WithAdditionalOTLPOutput(name string, opts ...OTLPOutputOption) *LogPipelineBuilder {
+	b.additionalOutputs = append(b.additionalOutputs, namedOTLPOutput(name, opts...))
+	return b
+}
+
+func (b *LogPipelineBuilder) WithFailoverOTLPOutput(opts ...OTLPOutputOption) *LogPipelineBuilder {
+	b.failoverOutput = defaultOTLPOutput()
+	for _, opt := range opts {
+		opt(b.failoverOutput)
+	}
+
+	return b
+}
+
 func (b *LogPipelineBuilder) WithOAuth2(opts ...OAuth2Option) *LogPipelineBuilder {
 	if b.oauth2 == nil {
 		b.oauth2 = &telemetryv1beta1.OAuth2Options{}
@@ -281,6 +432,11 @@ func (b *LogPipelineBuilder) WithCustomOutput(custom string) *LogPipelineBuilder
 	return b
 }
 
+func (b *LogPipelineBuilder) WithRedaction(redaction *telemetryv1beta1.RedactionSpec) *LogPipelineBuilder {
+	b.redaction = redaction
+	return b
+}
+
 func (b *LogPipelineBuilder) WithTransform(transform telemetryv1beta1.TransformSpec) *LogPipelineBuilder {
 	b.transforms = append(b.transforms, transform)
 	return b
@@ -291,6 +447,11 @@ func (b *LogPipelineBuilder) WithFilter(filter telemetryv1beta1.FilterSpec) *Log
 	return b
 }
 
+func (b *LogPipelineBuilder) WithDeduplicate(deduplicate *telemetryv1beta1.LogPipelineDeduplicate) *LogPipelineBuilder {
+	b.deduplicate = deduplicate
+	return b
+}
+
 func (b *LogPipelineBuilder) WithDeletionTimeStamp(ts metav1.Time) *LogPipelineBuilder {
 	b.deletionTimeStamp = ts
 	return b
@@ -311,7 +472,7 @@ func (b *LogPipelineBuilder) Build() telemetryv1beta1.LogPipeline {
 		b.name = fmt.Sprintf("test-%d", b.randSource.Int63())
 	}
 
-	if b.httpOutput == nil && b.customOutput == "" && b.otlpOutput == nil {
+	if b.httpOutput == nil && b.customOutput == "" && b.otlpOutput == nil && b.kafkaOutput == nil && b.lokiOutput == nil && b.esOutput == nil {
 		b.httpOutput = defaultHTTPOutput()
 	}
 
@@ -332,11 +493,18 @@ func (b *LogPipelineBuilder) Build() telemetryv1beta1.LogPipeline {
 				FluentBitHTTP:   b.httpOutput,
 				FluentBitCustom: b.customOutput,
 				OTLP:            b.otlpOutput,
+				Kafka:           b.kafkaOutput,
+				Loki:            b.lokiOutput,
+				Elasticsearch:   b.esOutput,
+				Failover:        b.failoverOutput,
 			},
+			AdditionalOutputs:  b.additionalOutputs,
 			FluentBitFiles:     b.files,
 			FluentBitVariables: b.variables,
+			Redaction:          b.redaction,
 			Transforms:         b.transforms,
 			Filters:            b.filters,
+			Deduplicate:        b.deduplicate,
 		},
 		Status: telemetryv1beta1.LogPipelineStatus{
 			Conditions: b.statusConditions,
@@ -362,9 +530,25 @@ func defaultHTTPOutput() *telemetryv1beta1.FluentBitHTTPOutput {
 	}
 }
 
+func namedOTLPOutput(name string, opts ...OTLPOutputOption) telemetryv1beta1.NamedOTLPOutput {
+	output := defaultOTLPOutput()
+	for _, opt := range opts {
+		opt(output)
+	}
+
+	return telemetryv1beta1.NamedOTLPOutput{Name: name, OTLP: output}
+}
+
 func defaultOTLPOutput() *telemetryv1beta1.OTLPOutput {
 	return &telemetryv1beta1.OTLPOutput{
 		Endpoint: telemetryv1beta1.ValueType{Value: "https://localhost:4317"},
 		Protocol: telemetryv1beta1.OTLPProtocolGRPC,
 	}
 }
+
+func defaultKafkaOutput() *telemetryv1beta1.KafkaOutput {
+	return &telemetryv1beta1.KafkaOutput{
+		Brokers: []string{"localhost:9092"},
+		Topic:   "telemetry",
+	}
+}
@@ -20,13 +20,21 @@ type MetricPipelineBuilder struct {
 	inRuntime    *telemetryv1beta1.MetricPipelineRuntimeInput
 	inPrometheus *telemetryv1beta1.MetricPipelinePrometheusInput
 	inIstio      *telemetryv1beta1.MetricPipelineIstioInput
+	inHost       *telemetryv1beta1.MetricPipelineHostInput
 	inOTLP       *telemetryv1beta1.OTLPInput
 
-	outOTLP *telemetryv1beta1.MetricPipelineOTLPOutput
-	oauth2  *telemetryv1beta1.OAuth2Options
+	outOTLP           *telemetryv1beta1.MetricPipelineOTLPOutput
+	outKafka          *telemetryv1beta1.KafkaOutput
+	outPRW            *telemetryv1beta1.PrometheusRemoteWriteOutput
+	outPrometheus     *telemetryv1beta1.PrometheusExpositionOutput
+	oauth2            *telemetryv1beta1.OAuth2Options
+	additionalOutputs []telemetryv1beta1.NamedOTLPOutput
+	failoverOutput    *telemetryv1beta1.OTLPOutput
 
 	transforms       []telemetryv1beta1.TransformSpec
+	redaction        *telemetryv1beta1.RedactionSpec
 	filter           []telemetryv1beta1.FilterSpec
+	aggregations     []telemetryv1beta1.MetricAggregation
 	statusConditions []metav1.Condition
 }
 
@@ -166,6 +174,10 @@ func (b *MetricPipelineBuilder) WithInput(input telemetryv1beta1.MetricPipelineI
 		b.inIstio = input.Istio
 	}
 
+	if input.Host != nil {
+		b.inHost = input.Host
+	}
+
 	if input.OTLP != nil {
 		b.inOTLP = input.OTLP
 	}
@@ -195,6 +207,36 @@ func (b *MetricPipelineBuilder) WithRuntimeInput(enable bool, opts ...NamespaceS
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithHostInput(enable bool) *MetricPipelineBuilder {
+	if b.inHost == nil {
+		b.inHost = &telemetryv1beta1.MetricPipelineHostInput{}
+	}
+
+	b.inHost.Enabled = &enable
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithHostInputScrapers(scrapers *telemetryv1beta1.MetricPipelineHostInputScrapers) *MetricPipelineBuilder {
+	if b.inHost == nil {
+		b.inHost = &telemetryv1beta1.MetricPipelineHostInput{}
+	}
+
+	b.inHost.Scrapers = scrapers
+
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithHostInputExcludeMountPoints(mountPoints ...string) *MetricPipelineBuilder {
+	if b.inHost == nil {
+		b.inHost = &telemetryv1beta1.MetricPipelineHostInput{}
+	}
+
+	b.inHost.ExcludeMountPoints = mountPoints
+
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithPrometheusInput(enable bool, opts ...NamespaceSelectorOptions) *MetricPipelineBuilder {
 	if b.inPrometheus == nil {
 		b.inPrometheus = &telemetryv1beta1.MetricPipelinePrometheusInput{}
@@ -275,6 +317,20 @@ func (b *MetricPipelineBuilder) WithPrometheusInputDiagnosticMetrics(enable bool
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithPrometheusInputMonitors(enable bool) *MetricPipelineBuilder {
+	if b.inPrometheus == nil {
+		b.inPrometheus = &telemetryv1beta1.MetricPipelinePrometheusInput{}
+	}
+
+	if b.inPrometheus.Monitors == nil {
+		b.inPrometheus.Monitors = &telemetryv1beta1.MetricPipelinePrometheusInputMonitors{}
+	}
+
+	b.inPrometheus.Monitors.Enabled = &enable
+
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithIstioInputDiagnosticMetrics(enable bool) *MetricPipelineBuilder {
 	if b.inIstio == nil {
 		b.inIstio = &telemetryv1beta1.MetricPipelineIstioInput{}
@@ -403,6 +459,59 @@ func (b *MetricPipelineBuilder) WithMetricPipelineOTLPOutput(opts ...OTLPOutputO
 	return b
 }
 
+// WithKafkaOutput replaces the OTLP output of the pipeline with a Kafka output.
+func (b *MetricPipelineBuilder) WithKafkaOutput(opts ...KafkaOutputOption) *MetricPipelineBuilder {
+	b.outOTLP = nil
+	b.outKafka = defaultKafkaOutput()
+
+	for _, opt := range opts {
+		opt(b.outKafka)
+	}
+
+	return b
+}
+
+// WithPrometheusRemoteWriteOutput replaces the OTLP output of the pipeline with a Prometheus remote-write output.
+// The OTLP output options for endpoint, authentication, headers, and TLS apply to the remote-write output as well.
+func (b *MetricPipelineBuilder) WithPrometheusRemoteWriteOutput(opts ...OTLPOutputOption) *MetricPipelineBuilder {
+	output := telemetryv1beta1.OTLPOutput{
+		Endpoint: telemetryv1beta1.ValueType{Value: "http://localhost:9090/api/v1/write"},
+	}
+
+	for _, opt := range opts {
+		opt(&output)
+	}
+
+	b.outOTLP = nil
+	b.outPRW = &telemetryv1beta1.PrometheusRemoteWriteOutput{
+		Endpoint:       output.Endpoint,
+		Authentication: output.Authentication,
+		Headers:        output.Headers,
+		TLS:            output.TLS,
+	}
+
+	return b
+}
+
+// WithPrometheusOutput replaces the OTLP output of the pipeline with a Prometheus exposition output.
+func (b *MetricPipelineBuilder) WithPrometheusOutput(opts ...PrometheusOutputOption) *MetricPipelineBuilder {
+	output := &telemetryv1beta1.PrometheusExpositionOutput{}
+	for _, opt := range opts {
+		opt(output)
+	}
+
+	b.outOTLP = nil
+	b.outPrometheus = output
+
+	return b
+}
+
+// WithResourceAttributesToLabels sets whether the Prometheus remote-write output adds all resource attributes as labels.
+func (b *MetricPipelineBuilder) WithResourceAttributesToLabels(enabled bool) *MetricPipelineBuilder {
+	b.outPRW.ResourceAttributesToLabels = &enabled
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithTemporality(temporality telemetryv1beta1.TemporalityType) *MetricPipelineBuilder {
 	b.outOTLP.Temporality = &temporality
 	return b
@@ -427,6 +536,16 @@ func (b *MetricPipelineBuilder) WithOAuth2(opts ...OAuth2Option) *MetricPipeline
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithRedaction(redaction *telemetryv1beta1.RedactionSpec) *MetricPipelineBuilder {
+	b.redaction = redaction
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithAggregation(aggregation telemetryv1beta1.MetricAggregation) *MetricPipelineBuilder {
+	b.aggregations = append(b.aggregations, aggregation)
+	return b
+}
+
 func (b *MetricPipelineBuilder) WithTransform(transform telemetryv1beta1.TransformSpec) *MetricPipelineBuilder {
 	b.transforms = append(b.transforms, transform)
 	return b
@@ -442,6 +561,20 @@ func (b *MetricPipelineBuilder) WithStatusCondition(cond metav1.Condition) *Metr
 	return b
 }
 
+func (b *MetricPipelineBuilder) WithAdditionalOTLPOutput(name string, opts ...OTLPOutputOption) *MetricPipelineBuilder {
+	b.additionalOutputs = append(b.additionalOutputs, namedOTLPOutput(name, opts...))
+	return b
+}
+
+func (b *MetricPipelineBuilder) WithFailoverOTLPOutput(opts ...OTLPOutputOption) *MetricPipelineBuilder {
+	b.failoverOutput = defaultOTLPOutput()
+	for _, opt := range opts {
+		opt(b.failoverOutput)
+	}
+
+	return b
+}
+
 func (b *MetricPipelineBuilder) Build() telemetryv1beta1.MetricPipeline {
 	name := b.name
 	if name == "" {
@@ -466,13 +599,21 @@ func (b *MetricPipelineBuilder) Build() telemetryv1beta1.MetricPipeline {
 				Runtime:    b.inRuntime,
 				Prometheus: b.inPrometheus,
 				Istio:      b.inIstio,
+				Host:       b.inHost,
 				OTLP:       b.inOTLP,
 			},
 			Output: telemetryv1beta1.MetricPipelineOutput{
-				OTLP: b.outOTLP,
+				OTLP:                  b.outOTLP,
+				Kafka:                 b.outKafka,
+				PrometheusRemoteWrite: b.outPRW,
+				Prometheus:            b.outPrometheus,
+				Failover:              b.failoverOutput,
 			},
-			Transforms: b.transforms,
-			Filters:    b.filter,
+			AdditionalOutputs: b.additionalOutputs,
+			Redaction:         b.redaction,
+			Transforms:        b.transforms,
+			Filters:           b.filter,
+			Aggregations:      b.aggregations,
 		},
 	}
 
@@ -2,6 +2,9 @@ package test
 
 import (
 	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 )
@@ -149,12 +152,103 @@ func OTLPCompression(compression telemetryv1beta1.OTLPCompressionEncoding) OTLPO
 	}
 }
 
+func OTLPPersistentQueue(enabled bool) OTLPOutputOption {
+	return func(output *telemetryv1beta1.OTLPOutput) {
+		output.PersistentQueue = &telemetryv1beta1.PersistentQueue{Enabled: enabled}
+	}
+}
+
 func OTLPEndpointPath(path string) OTLPOutputOption {
 	return func(output *telemetryv1beta1.OTLPOutput) {
 		output.Path = path
 	}
 }
 
+type KafkaOutputOption func(*telemetryv1beta1.KafkaOutput)
+
+func KafkaBrokers(brokers ...string) KafkaOutputOption {
+	return func(output *telemetryv1beta1.KafkaOutput) {
+		output.Brokers = brokers
+	}
+}
+
+func KafkaTopic(topic string) KafkaOutputOption {
+	return func(output *telemetryv1beta1.KafkaOutput) {
+		output.Topic = topic
+	}
+}
+
+func KafkaEncoding(encoding telemetryv1beta1.KafkaEncoding) KafkaOutputOption {
+	return func(output *telemetryv1beta1.KafkaOutput) {
+		output.Encoding = encoding
+	}
+}
+
+func KafkaPartitionBy(key telemetryv1beta1.KafkaPartitionKey) KafkaOutputOption {
+	return func(output *telemetryv1beta1.KafkaOutput) {
+		output.PartitionBy = key
+	}
+}
+
+func KafkaSASL(mechanism telemetryv1beta1.KafkaSASLMechanism, user, password string) KafkaOutputOption {
+	return func(output *telemetryv1beta1.KafkaOutput) {
+		output.Authentication = &telemetryv1beta1.KafkaAuthenticationOptions{
+			SASL: &telemetryv1beta1.KafkaSASLOptions{
+				Mechanism: mechanism,
+				User:      telemetryv1beta1.ValueType{Value: user},
+				Password:  telemetryv1beta1.ValueType{Value: password},
+			},
+		}
+	}
+}
+
+func KafkaSASLFromSecret(secretName, secretNamespace, userKey, passwordKey string) KafkaOutputOption {
+	return func(output *telemetryv1beta1.KafkaOutput) {
+		output.Authentication = &telemetryv1beta1.KafkaAuthenticationOptions{
+			SASL: &telemetryv1beta1.KafkaSASLOptions{
+				User: telemetryv1beta1.ValueType{
+					ValueFrom: &telemetryv1beta1.ValueFromSource{
+						SecretKeyRef: &telemetryv1beta1.SecretKeyRef{
+							Name:      secretName,
+							Namespace: secretNamespace,
+							Key:       userKey,
+						},
+					},
+				},
+				Password: telemetryv1beta1.ValueType{
+					ValueFrom: &telemetryv1beta1.ValueFromSource{
+						SecretKeyRef: &telemetryv1beta1.SecretKeyRef{
+							Name:      secretName,
+							Namespace: secretNamespace,
+							Key:       passwordKey,
+						},
+					},
+				},
+			},
+		}
+	}
+}
+
+func KafkaClientTLS(tls *telemetryv1beta1.OutputTLS) KafkaOutputOption {
+	return func(output *telemetryv1beta1.KafkaOutput) {
+		output.TLS = tls
+	}
+}
+
+type PrometheusOutputOption func(*telemetryv1beta1.PrometheusExpositionOutput)
+
+func PrometheusExpiration(expiration time.Duration) PrometheusOutputOption {
+	return func(output *telemetryv1beta1.PrometheusExpositionOutput) {
+		output.Expiration = &metav1.Duration{Duration: expiration}
+	}
+}
+
+func PrometheusStaleness(staleness time.Duration) PrometheusOutputOption {
+	return func(output *telemetryv1beta1.PrometheusExpositionOutput) {
+		output.Staleness = &metav1.Duration{Duration: staleness}
+	}
+}
+
 type OAuth2Option func(oauth2 *telemetryv1beta1.OAuth2Options)
 
 func OAuth2ClientID(clientID string) OAuth2Option {
@@ -17,10 +17,17 @@ type TracePipelineBuilder struct {
 	labels map[string]string
 
 	transforms       []telemetryv1beta1.TransformSpec
+	redaction        *telemetryv1beta1.RedactionSpec
 	filters          []telemetryv1beta1.FilterSpec
 	statusConditions []metav1.Condition
 	outOTLP          *telemetryv1beta1.OTLPOutput
+	outKafka         *telemetryv1beta1.KafkaOutput
 	oauth2           *telemetryv1beta1.OAuth2Options
+	sampling         *telemetryv1beta1.TracePipelineSampling
+	metrics          *telemetryv1beta1.TracePipelineMetrics
+
+	additionalOutputs []telemetryv1beta1.NamedOTLPOutput
+	failoverOutput    *telemetryv1beta1.OTLPOutput
 }
 
 func NewTracePipelineBuilder() *TracePipelineBuilder {
@@ -60,6 +67,32 @@ func (b *TracePipelineBuilder) WithOTLPOutput(opts ...OTLPOutputOption) *TracePi
 	return b
 }
 
+// WithKafkaOutput replaces the OTLP output of the pipeline with a Kafka output.
+func (b *TracePipelineBuilder) WithKafkaOutput(opts ...KafkaOutputOption) *TracePipelineBuilder {
+	b.outOTLP = nil
+	b.outKafka = defaultKafkaOutput()
+
+	for _, opt := range opts {
+		opt(b.outKafka)
+	}
+
+	return b
+}
+
+func (b *TracePipelineBuilder) WithAdditionalOTLPOutput(name string, opts ...OTLPOutputOption) *TracePipelineBuilder {
+	b.additionalOutputs = append(b.additionalOutputs, namedOTLPOutput(name, opts...))
+	return b
+}
+
+func (b *TracePipelineBuilder) WithFailoverOTLPOutput(opts ...OTLPOutputOption) *TracePipelineBuilder {
+	b.failoverOutput = defaultOTLPOutput()
+	for _, opt := range opts {
+		opt(b.failoverOutput)
+	}
+
+	return b
+}
+
 func (b *TracePipelineBuilder) WithOAuth2(opts ...OAuth2Option) *TracePipelineBuilder {
 	if b.oauth2 == nil {
 		b.oauth2 = &telemetryv1beta1.OAuth2Options{}
@@ -79,6 +112,11 @@ func (b *TracePipelineBuilder) WithOAuth2(opts ...OAuth2Option) *TracePipelineBu
 	return b
 }
 
+func (b *TracePipelineBuilder) WithRedaction(redaction *telemetryv1beta1.RedactionSpec) *TracePipelineBuilder {
+	b.redaction = redaction
+	return b
+}
+
 func (b *TracePipelineBuilder) WithTransform(transform telemetryv1beta1.TransformSpec) *TracePipelineBuilder {
 	b.transforms = append(b.transforms, transform)
 	return b
@@ -89,6 +127,16 @@ func (b *TracePipelineBuilder) WithFilter(filter telemetryv1beta1.FilterSpec) *T
 	return b
 }
 
+func (b *TracePipelineBuilder) WithSampling(sampling *telemetryv1beta1.TracePipelineSampling) *TracePipelineBuilder {
+	b.sampling = sampling
+	return b
+}
+
+func (b *TracePipelineBuilder) WithMetrics(metrics *telemetryv1beta1.TracePipelineMetrics) *TracePipelineBuilder {
+	b.metrics = metrics
+	return b
+}
+
 func (b *TracePipelineBuilder) Build() telemetryv1beta1.TracePipeline {
 	name := b.name
 	if name == "" {
@@ -107,10 +155,16 @@ func (b *TracePipelineBuilder) Build() telemetryv1beta1.TracePipeline {
 		},
 		Spec: telemetryv1beta1.TracePipelineSpec{
 			Output: telemetryv1beta1.TracePipelineOutput{
-				OTLP: b.outOTLP,
+				OTLP:     b.outOTLP,
+				Kafka:    b.outKafka,
+				Failover: b.failoverOutput,
 			},
-			Transforms: b.transforms,
-			Filters:    b.filters,
+			AdditionalOutputs: b.additionalOutputs,
+			Redaction:         b.redaction,
+			Transforms:        b.transforms,
+			Filters:           b.filters,
+			Sampling:          b.sampling,
+			Metrics:           b.metrics,
 		},
 		Status: telemetryv1beta1.TracePipelineStatus{
 			Conditions: b.statusConditions,
@@ -16,6 +16,7 @@ import (
 
 const (
 	FluentdProtocolHTTP = "fluentd-http"
+	KafkaProtocol       = "kafka"
 	OTLPProtocolGRPC    = telemetryv1beta1.OTLPProtocolGRPC
 	OTLPProtocolHTTP    = telemetryv1beta1.OTLPProtocolHTTP
 )
@@ -38,6 +39,7 @@ var (
 	ErrGRPCOAuth2NoTLS    = errors.New("OAuth2 requires TLS when using gRPC protocol")
 	ErrHTTPWithTLS        = errors.New("HTTP scheme with TLS not allowed")
 	ErrGRPCWithPath       = errors.New("gRPC endpoints cannot contain paths")
+	ErrKafkaBrokerInvalid = errors.New("kafka broker address must have the format <host>:<port>")
 )
 
 type EndpointInvalidError struct {
@@ -67,6 +69,11 @@ func (v *Validator) Validate(ctx context.Context, params EndpointValidationParam
 		return err
 	}
 
+	// Kafka brokers are plain addresses without scheme or path
+	if params.Protocol == KafkaProtocol {
+		return ValidateKafkaBroker(endpointValue)
+	}
+
 	var u *url.URL
 
 	if u, err = parseEndpoint(endpointValue); err != nil {
@@ -124,6 +131,24 @@ func (v *Validator) Validate(ctx context.Context, params EndpointValidationParam
 	return nil
 }
 
+// ValidateKafkaBroker validates that the given Kafka broker address has the format <host>:<port>.
+func ValidateKafkaBroker(broker string) error {
+	host, port, err := net.SplitHostPort(broker)
+	if err != nil && strings.Contains(err.Error(), "missing port in address") {
+		return &EndpointInvalidError{Err: ErrPortMissing}
+	}
+
+	if err != nil || host == "" || strings.ContainsAny(host, "/?#") {
+		return &EndpointInvalidError{Err: ErrKafkaBrokerInvalid}
+	}
+
+	if _, err := strconv.Atoi(port); err != nil {
+		return &EndpointInvalidError{Err: ErrPortMissing}
+	}
+
+	return nil
+}
+
 func resolveValue(ctx context.Context, c client.Reader, value telemetryv1beta1.ValueType) (string, error) {
 	if value.Value != "" {
 		return value.Value, nil
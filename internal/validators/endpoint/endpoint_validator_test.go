@@ -487,6 +487,45 @@ func TestFluentdHttpEndpoints(t *testing.T) {
 	}
 }
 
+func TestKafkaBrokers(t *testing.T) {
+	tests := []struct {
+		name        string
+		broker      string
+		expectedErr error
+	}{
+		{name: "valid broker", broker: "kafka.example.com:9092"},
+		{name: "valid broker with IP", broker: "10.0.0.1:9093"},
+		{name: "missing port", broker: "kafka.example.com", expectedErr: ErrPortMissing},
+		{name: "invalid port", broker: "kafka.example.com:abc", expectedErr: ErrPortMissing},
+		{name: "with scheme", broker: "kafka://kafka.example.com:9092", expectedErr: ErrKafkaBrokerInvalid},
+		{name: "with path", broker: "kafka.example.com/foo:9092", expectedErr: ErrKafkaBrokerInvalid},
+		{name: "missing host", broker: ":9092", expectedErr: ErrKafkaBrokerInvalid},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := Validator{
+				Client: fake.NewClientBuilder().Build(),
+			}
+
+			err := validator.Validate(
+				t.Context(),
+				EndpointValidationParams{
+					Endpoint: &telemetryv1beta1.ValueType{Value: test.broker},
+					Protocol: KafkaProtocol,
+				})
+
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.True(t, IsEndpointInvalidError(err))
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func TestMissingEndpoint(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().Build()
 	validator := Validator{
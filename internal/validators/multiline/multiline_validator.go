@@ -0,0 +1,48 @@
+package multiline
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+type InvalidMultilinePatternError struct {
+	Err error
+}
+
+func (e *InvalidMultilinePatternError) Error() string {
+	return e.Err.Error()
+}
+
+func IsInvalidMultilinePatternError(err error) bool {
+	var errInvalidMultilinePattern *InvalidMultilinePatternError
+	return errors.As(err, &errInvalidMultilinePattern)
+}
+
+type Validator struct{}
+
+// Validate checks that the custom patterns of the multiline rules are valid regular expressions.
+// Invalid patterns would break the configuration of the Log Agent for all pipelines.
+func (v *Validator) Validate(lp *telemetryv1beta1.LogPipeline) error {
+	if lp.Spec.Input.Runtime == nil {
+		return nil
+	}
+
+	for _, rule := range lp.Spec.Input.Runtime.Multiline {
+		for _, pattern := range []string{rule.StartPattern, rule.ContinuationPattern} {
+			if pattern == "" {
+				continue
+			}
+
+			if _, err := regexp.Compile(pattern); err != nil {
+				return &InvalidMultilinePatternError{
+					Err: fmt.Errorf("invalid multiline pattern %q: %w", pattern, err),
+				}
+			}
+		}
+	}
+
+	return nil
+}
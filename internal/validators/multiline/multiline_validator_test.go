@@ -0,0 +1,66 @@
+package multiline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
+)
+
+func TestValidate(t *testing.T) {
+	sut := &Validator{}
+
+	tests := []struct {
+		name      string
+		pipeline  telemetryv1beta1.LogPipeline
+		expectErr bool
+	}{
+		{
+			name:     "no multiline rules",
+			pipeline: testutils.NewLogPipelineBuilder().WithRuntimeInput(true).Build(),
+		},
+		{
+			name: "preset",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithMultiline(telemetryv1beta1.LogPipelineMultiline{Preset: telemetryv1beta1.MultilinePresetJava}).
+				Build(),
+		},
+		{
+			name: "valid custom patterns",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithMultiline(telemetryv1beta1.LogPipelineMultiline{StartPattern: `^\d{4}-\d{2}-\d{2}`, ContinuationPattern: `^\s+`}).
+				Build(),
+		},
+		{
+			name: "invalid start pattern",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithMultiline(telemetryv1beta1.LogPipelineMultiline{StartPattern: `^(\d{4}`}).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "invalid continuation pattern in second rule",
+			pipeline: testutils.NewLogPipelineBuilder().
+				WithMultiline(
+					telemetryv1beta1.LogPipelineMultiline{Preset: telemetryv1beta1.MultilinePresetGo},
+					telemetryv1beta1.LogPipelineMultiline{ContinuationPattern: `^\s+(?<at`},
+				).
+				Build(),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := sut.Validate(&tt.pipeline)
+			if tt.expectErr {
+				require.Error(t, err)
+				require.True(t, IsInvalidMultilinePatternError(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
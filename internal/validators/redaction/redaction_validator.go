@@ -0,0 +1,42 @@
+package redaction
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+type InvalidRedactionPatternError struct {
+	Err error
+}
+
+func (e *InvalidRedactionPatternError) Error() string {
+	return e.Err.Error()
+}
+
+func IsInvalidRedactionPatternError(err error) bool {
+	var errInvalidRedactionPattern *InvalidRedactionPatternError
+	return errors.As(err, &errInvalidRedactionPattern)
+}
+
+type Validator struct{}
+
+// Validate checks that the custom patterns of the redaction are valid regular expressions.
+// Invalid patterns would break the configuration of the agents and the gateway for all pipelines.
+func (v *Validator) Validate(redaction *telemetryv1beta1.RedactionSpec) error {
+	if redaction == nil {
+		return nil
+	}
+
+	for _, pattern := range redaction.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return &InvalidRedactionPatternError{
+				Err: fmt.Errorf("invalid redaction pattern %q: %w", pattern, err),
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package redaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+)
+
+func TestValidate(t *testing.T) {
+	sut := &Validator{}
+
+	tests := []struct {
+		name      string
+		redaction *telemetryv1beta1.RedactionSpec
+		expectErr bool
+	}{
+		{
+			name: "no redaction",
+		},
+		{
+			name: "detectors only",
+			redaction: &telemetryv1beta1.RedactionSpec{
+				Detectors: []telemetryv1beta1.RedactionDetector{telemetryv1beta1.RedactionDetectorEmail},
+			},
+		},
+		{
+			name: "valid custom patterns",
+			redaction: &telemetryv1beta1.RedactionSpec{
+				Patterns: []string{`customer-[0-9]{6}`, `(?i)password=\S+`},
+			},
+		},
+		{
+			name: "invalid custom pattern",
+			redaction: &telemetryv1beta1.RedactionSpec{
+				Patterns: []string{`customer-[0-9]{6}`, `(?<name>\d+`},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := sut.Validate(tt.redaction)
+			if tt.expectErr {
+				require.Error(t, err)
+				require.True(t, IsInvalidRedactionPatternError(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/errortypes"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
 )
 
 type Validator struct {
@@ -99,21 +100,49 @@ func checkForMissingFields(ref telemetryv1beta1.SecretKeyRef) error {
 }
 
 func GetSecretRefsTracePipeline(tp *telemetryv1beta1.TracePipeline) []telemetryv1beta1.SecretKeyRef {
-	return getSecretRefsInOTLPOutput(tp.Spec.Output.OTLP)
+	return append(getSecretRefsInOTLPOutputs(pipelines.TracePipelineOutputs(tp)), getSecretRefsInKafkaOutputs(pipelines.TracePipelineKafkaOutputs(tp))...)
 }
 
 func GetSecretRefsMetricPipeline(mp *telemetryv1beta1.MetricPipeline) []telemetryv1beta1.SecretKeyRef {
-	return getSecretRefsInOTLPOutput(&mp.Spec.Output.OTLP.OTLPOutput)
+	outputs := pipelines.MetricPipelineOutputs(mp)
+	for _, output := range pipelines.MetricPipelinePrometheusRemoteWriteOutputs(mp) {
+		outputs = append(outputs, output.OTLPOutput())
+	}
+
+	return append(getSecretRefsInOTLPOutputs(outputs), getSecretRefsInKafkaOutputs(pipelines.MetricPipelineKafkaOutputs(mp))...)
 }
 
 func GetSecretRefsLogPipeline(lp *telemetryv1beta1.LogPipeline) []telemetryv1beta1.SecretKeyRef {
-	if lp.Spec.Output.OTLP != nil {
-		return getSecretRefsInOTLPOutput(lp.Spec.Output.OTLP)
+	if lp.Spec.Output.OTLP != nil || lp.Spec.Output.Kafka != nil || lp.Spec.Output.Loki != nil || lp.Spec.Output.Elasticsearch != nil {
+		outputs := pipelines.LogPipelineOutputs(lp)
+		for _, output := range pipelines.LogPipelineElasticsearchOutputs(lp) {
+			outputs = append(outputs, output.OTLPOutput())
+		}
+
+		return append(getSecretRefsInOTLPOutputs(outputs), getSecretRefsInKafkaOutputs(pipelines.LogPipelineKafkaOutputs(lp))...)
 	}
 
 	return getSecretRefsFluentBitLogPipeline(lp)
 }
 
+func getSecretRefsInOTLPOutputs(outputs []pipelines.OTLPOutput) []telemetryv1beta1.SecretKeyRef {
+	var refs []telemetryv1beta1.SecretKeyRef
+	for _, output := range outputs {
+		refs = append(refs, getSecretRefsInOTLPOutput(output.OTLP)...)
+	}
+
+	return refs
+}
+
+func getSecretRefsInKafkaOutputs(outputs []pipelines.KafkaOutput) []telemetryv1beta1.SecretKeyRef {
+	var refs []telemetryv1beta1.SecretKeyRef
+	for _, output := range outputs {
+		refs = append(refs, getSecretRefsInKafkaOutput(output.Kafka)...)
+	}
+
+	return refs
+}
+
 func getSecretRefsFluentBitLogPipeline(lp *telemetryv1beta1.LogPipeline) []telemetryv1beta1.SecretKeyRef {
 	var refs []telemetryv1beta1.SecretKeyRef
 
@@ -176,6 +205,23 @@ func getSecretRefsInOTLPOutput(otlpOut *telemetryv1beta1.OTLPOutput) []telemetry
 	return refs
 }
 
+func getSecretRefsInKafkaOutput(kafkaOut *telemetryv1beta1.KafkaOutput) []telemetryv1beta1.SecretKeyRef {
+	var refs []telemetryv1beta1.SecretKeyRef
+
+	if kafkaOut.Authentication != nil && kafkaOut.Authentication.SASL != nil {
+		refs = appendIfSecretRef(refs, &kafkaOut.Authentication.SASL.User)
+		refs = appendIfSecretRef(refs, &kafkaOut.Authentication.SASL.Password)
+	}
+
+	if kafkaOut.TLS != nil && !kafkaOut.TLS.Insecure {
+		refs = appendIfSecretRef(refs, kafkaOut.TLS.CA)
+		refs = appendIfSecretRef(refs, kafkaOut.TLS.Cert)
+		refs = appendIfSecretRef(refs, kafkaOut.TLS.Key)
+	}
+
+	return refs
+}
+
 func appendIfSecretRef(secretKeyRefs []telemetryv1beta1.SecretKeyRef, valueType *telemetryv1beta1.ValueType) []telemetryv1beta1.SecretKeyRef {
 	if valueType != nil && valueType.Value == "" && valueType.ValueFrom != nil && valueType.ValueFrom.SecretKeyRef != nil {
 		secretKeyRefs = append(secretKeyRefs, *valueType.ValueFrom.SecretKeyRef)
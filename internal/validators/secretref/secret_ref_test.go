@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	testutils "github.com/kyma-project/telemetry-manager/internal/utils/test"
 )
 
 func TestValidate(t *testing.T) {
@@ -342,6 +343,56 @@ func TestTracePipeline_GetSecretRefs(t *testing.T) {
 	}
 }
 
+func TestTracePipeline_GetSecretRefsWithAdditionalOutputs(t *testing.T) {
+	sut := testutils.NewTracePipelineBuilder().
+		WithName("test-pipeline").
+		WithOTLPOutput(testutils.OTLPEndpointFromSecret("secret-1", "default", "endpoint")).
+		WithAdditionalOTLPOutput("archive", testutils.OTLPEndpointFromSecret("secret-2", "default", "endpoint")).
+		Build()
+
+	actual := GetSecretRefsTracePipeline(&sut)
+	require.ElementsMatch(t, []telemetryv1beta1.SecretKeyRef{
+		{Name: "secret-1", Namespace: "default", Key: "endpoint"},
+		{Name: "secret-2", Namespace: "default", Key: "endpoint"},
+	}, actual)
+}
+
+func TestTracePipeline_GetSecretRefsWithKafkaOutput(t *testing.T) {
+	sut := testutils.NewTracePipelineBuilder().
+		WithName("test-pipeline").
+		WithKafkaOutput(
+			testutils.KafkaSASLFromSecret("kafka-creds", "default", "user", "password"),
+			testutils.KafkaClientTLS(&telemetryv1beta1.OutputTLS{
+				CA: &telemetryv1beta1.ValueType{ValueFrom: &telemetryv1beta1.ValueFromSource{SecretKeyRef: &telemetryv1beta1.SecretKeyRef{Name: "kafka-tls", Namespace: "default", Key: "ca"}}},
+			}),
+		).
+		Build()
+
+	actual := GetSecretRefsTracePipeline(&sut)
+	require.ElementsMatch(t, []telemetryv1beta1.SecretKeyRef{
+		{Name: "kafka-creds", Namespace: "default", Key: "user"},
+		{Name: "kafka-creds", Namespace: "default", Key: "password"},
+		{Name: "kafka-tls", Namespace: "default", Key: "ca"},
+	}, actual)
+}
+
+func TestMetricPipeline_GetSecretRefsWithPrometheusRemoteWriteOutput(t *testing.T) {
+	sut := testutils.NewMetricPipelineBuilder().
+		WithName("test-pipeline").
+		WithPrometheusRemoteWriteOutput(
+			testutils.OTLPEndpointFromSecret("prw-endpoint", "default", "endpoint"),
+			testutils.OTLPBasicAuthFromSecret("prw-creds", "default", "user", "password"),
+		).
+		Build()
+
+	actual := GetSecretRefsMetricPipeline(&sut)
+	require.ElementsMatch(t, []telemetryv1beta1.SecretKeyRef{
+		{Name: "prw-endpoint", Namespace: "default", Key: "endpoint"},
+		{Name: "prw-creds", Namespace: "default", Key: "user"},
+		{Name: "prw-creds", Namespace: "default", Key: "password"},
+	}, actual)
+}
+
 func TestMetricPipeline_GetSecretRefs(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -593,6 +644,34 @@ func TestLogPipeline_GetSecretRefs(t *testing.T) {
 	}
 }
 
+func TestLogPipeline_GetSecretRefsWithLokiAndElasticsearchOutputs(t *testing.T) {
+	loki := testutils.NewLogPipelineBuilder().
+		WithName("test-loki").
+		WithLokiOutput(
+			testutils.OTLPEndpointFromSecret("loki-endpoint", "default", "endpoint"),
+			testutils.OTLPCustomHeader("X-Scope-OrgID", "tenant", ""),
+		).
+		Build()
+
+	require.ElementsMatch(t, []telemetryv1beta1.SecretKeyRef{
+		{Name: "loki-endpoint", Namespace: "default", Key: "endpoint"},
+	}, GetSecretRefsLogPipeline(&loki))
+
+	elasticsearch := testutils.NewLogPipelineBuilder().
+		WithName("test-elasticsearch").
+		WithElasticsearchOutput("logs", "",
+			testutils.OTLPEndpointFromSecret("es-endpoint", "default", "endpoint"),
+			testutils.OTLPBasicAuthFromSecret("es-creds", "default", "user", "password"),
+		).
+		Build()
+
+	require.ElementsMatch(t, []telemetryv1beta1.SecretKeyRef{
+		{Name: "es-endpoint", Namespace: "default", Key: "endpoint"},
+		{Name: "es-creds", Namespace: "default", Key: "user"},
+		{Name: "es-creds", Namespace: "default", Key: "password"},
+	}, GetSecretRefsLogPipeline(&elasticsearch))
+}
+
 func TestGetValue_SecretNotFound(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().Build()
 	ref := telemetryv1beta1.SecretKeyRef{Name: "my-secret1", Namespace: "default", Key: "myKey1"}
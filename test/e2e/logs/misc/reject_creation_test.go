@@ -40,7 +40,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 			pipeline: telemetryv1beta1.LogPipeline{
 				Spec: telemetryv1beta1.LogPipelineSpec{},
 			},
-			errorMsg: "Exactly one output out of 'custom', 'http' or 'otlp' must be defined",
+			errorMsg: "Exactly one output out of 'custom', 'http', 'otlp', 'kafka', 'loki' or 'elasticsearch' must be defined",
 			field:    "spec.output",
 		},
 		{
@@ -577,7 +577,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 				).
 				WithHTTPOutput().
 				Build(),
-			errorMsg: "Exactly one output out of 'custom', 'http' or 'otlp' must be defined",
+			errorMsg: "Exactly one output out of 'custom', 'http', 'otlp', 'kafka', 'loki' or 'elasticsearch' must be defined",
 			field:    "spec.output",
 		},
 		{
@@ -586,7 +586,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 				WithHTTPOutput().
 				WithOTLPInput(true).
 				Build(),
-			errorMsg: "otlp input is only supported with otlp output",
+			errorMsg: "otlp input is only supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 		{
@@ -595,7 +595,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 				WithCustomOutput("name icke").
 				WithOTLPInput(true).
 				Build(),
-			errorMsg: "otlp input is only supported with otlp output",
+			errorMsg: "otlp input is only supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 		{
@@ -607,7 +607,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 					testutils.OTLPEndpoint(backenEndpoint),
 				).
 				Build(),
-			errorMsg: "input.runtime.dropLabels is not supported with otlp output",
+			errorMsg: "input.runtime.dropLabels is not supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 		{
@@ -619,7 +619,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 					testutils.OTLPEndpoint(backenEndpoint),
 				).
 				Build(),
-			errorMsg: "input.runtime.keepAnnotations is not supported with otlp output",
+			errorMsg: "input.runtime.keepAnnotations is not supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 		{
@@ -631,7 +631,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 					testutils.OTLPEndpoint(backenEndpoint),
 				).
 				Build(),
-			errorMsg: "files not supported with otlp output",
+			errorMsg: "files not supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 		{
@@ -643,7 +643,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 					testutils.OTLPEndpoint(backenEndpoint),
 				).
 				Build(),
-			errorMsg: "filters are not supported with otlp output",
+			errorMsg: "filters are not supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 		{
@@ -655,7 +655,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 					testutils.OTLPEndpoint(backenEndpoint),
 				).
 				Build(),
-			errorMsg: "variables not supported with otlp output",
+			errorMsg: "variables not supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 		{
@@ -667,7 +667,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 				}).
 				WithHTTPOutput().
 				Build(),
-			errorMsg: "transform is only supported with otlp output",
+			errorMsg: "transform is only supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 		{
@@ -679,7 +679,7 @@ func TestRejectLogPipelineCreation(t *testing.T) {
 				}).
 				WithHTTPOutput().
 				Build(),
-			errorMsg: "filter is only supported with otlp output",
+			errorMsg: "filter is only supported with otlp, kafka, loki or elasticsearch output",
 			field:    "spec",
 		},
 	}
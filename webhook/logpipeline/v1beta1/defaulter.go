@@ -7,6 +7,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	logpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/logpipeline"
 )
 
 // +kubebuilder:object:generate=false
@@ -48,7 +49,7 @@ func (ld defaulter) Default(ctx context.Context, pipeline *telemetryv1beta1.LogP
 
 	if isOTLPPipeline(pipeline) {
 		if pipeline.Spec.Input.OTLP == nil {
-			pipeline.Spec.Input.OTLP = &telemetryv1beta1.OTLPInput{}
+			pipeline.Spec.Input.OTLP = &telemetryv1beta1.LogPipelineOTLPInput{}
 		}
 
 		if pipeline.Spec.Input.OTLP.Enabled == nil {
@@ -64,5 +65,5 @@ func (ld defaulter) Default(ctx context.Context, pipeline *telemetryv1beta1.LogP
 }
 
 func isOTLPPipeline(pipeline *telemetryv1beta1.LogPipeline) bool {
-	return pipeline.Spec.Output.OTLP != nil
+	return logpipelineutils.IsOTelOutputDefined(&pipeline.Spec.Output)
 }
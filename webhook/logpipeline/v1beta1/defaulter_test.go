@@ -153,9 +153,11 @@ func TestDefault(t *testing.T) {
 								Exclude: namespaces.System(),
 							},
 						},
-						OTLP: &telemetryv1beta1.OTLPInput{
-							Enabled:    new(true),
-							Namespaces: &telemetryv1beta1.NamespaceSelector{},
+						OTLP: &telemetryv1beta1.LogPipelineOTLPInput{
+							OTLPInput: telemetryv1beta1.OTLPInput{
+								Enabled:    new(true),
+								Namespaces: &telemetryv1beta1.NamespaceSelector{},
+							},
 						},
 					},
 					Output: telemetryv1beta1.LogPipelineOutput{
@@ -174,9 +176,11 @@ func TestDefault(t *testing.T) {
 						Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
 							Enabled: new(false),
 						},
-						OTLP: &telemetryv1beta1.OTLPInput{
-							Namespaces: &telemetryv1beta1.NamespaceSelector{
-								Exclude: []string{"custom-namespace"},
+						OTLP: &telemetryv1beta1.LogPipelineOTLPInput{
+							OTLPInput: telemetryv1beta1.OTLPInput{
+								Namespaces: &telemetryv1beta1.NamespaceSelector{
+									Exclude: []string{"custom-namespace"},
+								},
 							},
 						},
 					},
@@ -196,10 +200,12 @@ func TestDefault(t *testing.T) {
 						Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
 							Enabled: new(false),
 						},
-						OTLP: &telemetryv1beta1.OTLPInput{
-							Enabled: new(true),
-							Namespaces: &telemetryv1beta1.NamespaceSelector{
-								Exclude: []string{"custom-namespace"},
+						OTLP: &telemetryv1beta1.LogPipelineOTLPInput{
+							OTLPInput: telemetryv1beta1.OTLPInput{
+								Enabled: new(true),
+								Namespaces: &telemetryv1beta1.NamespaceSelector{
+									Exclude: []string{"custom-namespace"},
+								},
 							},
 						},
 					},
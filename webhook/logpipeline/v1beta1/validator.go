@@ -2,8 +2,16 @@ package v1beta1
 
 import (
 	"context"
+	"errors"
 	"fmt"
-
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
@@ -17,6 +25,10 @@ const (
 	migrationGuideLink = "https://kyma-project.io/#/telemetry-manager/user/integrate-otlp-backend/migration-to-otlp-logs"
 )
 
+const minDeduplicateInterval = time.Second
+
+var errDeduplicateInterval = errors.New("deduplicate interval must be at least 1s")
+
 type validator struct {
 }
 
@@ -41,6 +53,22 @@ func validate(ctx context.Context, pipeline *telemetryv1beta1.LogPipeline) (admi
 		return nil, err
 	}
 
+	if err := validatePodSelector(pipeline.Spec.Input.Runtime); err != nil {
+		return nil, err
+	}
+
+	if err := validateParser(pipeline.Spec.Input.Runtime); err != nil {
+		return nil, err
+	}
+
+	if err := validateDeduplicate(pipeline.Spec.Deduplicate); err != nil {
+		return nil, err
+	}
+
+	if err := webhookutils.ValidateKafkaOutput(pipelines.SignalTypeLog, pipeline.Spec.Output.Kafka); err != nil {
+		return nil, err
+	}
+
 	if logpipelineutils.IsCustomFilterDefined(pipeline.Spec.FluentBitFilters) {
 		warnings = append(warnings, renderDeprecationWarning(pipeline.Name, "filters"))
 	}
@@ -84,3 +112,54 @@ func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.
 
 	return nil
 }
+
+func validatePodSelector(runtime *telemetryv1beta1.LogPipelineRuntimeInput) error {
+	if runtime == nil || runtime.Pods == nil {
+		return nil
+	}
+
+	if runtime.Pods.Labels != nil {
+		if _, err := metav1.LabelSelectorAsSelector(runtime.Pods.Labels); err != nil {
+			return fmt.Errorf("invalid pod label selector: %w", err)
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(runtime.Pods.Annotations)) {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid pod annotation key '%s': %s", key, strings.Join(errs, "; "))
+		}
+	}
+
+	return nil
+}
+
+func validateParser(runtime *telemetryv1beta1.LogPipelineRuntimeInput) error {
+	if runtime == nil || runtime.Parser == nil || runtime.Parser.Format != telemetryv1beta1.ParserFormatRegex {
+		return nil
+	}
+
+	re, err := regexp.Compile(runtime.Parser.Regex)
+	if err != nil {
+		return fmt.Errorf("invalid parser regex: %w", err)
+	}
+
+	if !slices.ContainsFunc(re.SubexpNames(), func(name string) bool { return name != "" }) {
+		return errors.New("invalid parser regex: the regex must contain at least one named capture group")
+	}
+
+	return nil
+}
+
+// validateDeduplicate rejects intervals shorter than one second, because the deduplication would flush
+// almost every log record on its own and only add overhead.
+func validateDeduplicate(deduplicate *telemetryv1beta1.LogPipelineDeduplicate) error {
+	if deduplicate == nil || deduplicate.Interval == nil {
+		return nil
+	}
+
+	if deduplicate.Interval.Duration < minDeduplicateInterval {
+		return errDeduplicateInterval
+	}
+
+	return nil
+}
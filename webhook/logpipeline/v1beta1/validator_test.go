@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -74,6 +75,145 @@ func TestLogPipelineValidator_ValidateCreate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid pod selector",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Input: telemetryv1beta1.LogPipelineInput{
+						Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
+							Pods: &telemetryv1beta1.LogPipelinePodSelector{
+								Labels: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"app.kubernetes.io/name": "foo"},
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"backend"}},
+									},
+								},
+								Annotations: map[string]string{"example.com/logs": "enabled"},
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid pod selector - bad label selector operator",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Input: telemetryv1beta1.LogPipelineInput{
+						Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
+							Pods: &telemetryv1beta1.LogPipelinePodSelector{
+								Labels: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{Key: "tier", Operator: metav1.LabelSelectorOpExists, Values: []string{"backend"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid pod selector - bad annotation key",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Input: telemetryv1beta1.LogPipelineInput{
+						Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
+							Pods: &telemetryv1beta1.LogPipelinePodSelector{
+								Annotations: map[string]string{"not a key": "enabled"},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid parser regex",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Input: telemetryv1beta1.LogPipelineInput{
+						Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
+							Parser: &telemetryv1beta1.LogPipelineParser{
+								Format: telemetryv1beta1.ParserFormatRegex,
+								Regex:  `^(?P<level>\w+) (?P<message>.*)$`,
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid parser regex - bad syntax",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Input: telemetryv1beta1.LogPipelineInput{
+						Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
+							Parser: &telemetryv1beta1.LogPipelineParser{
+								Format: telemetryv1beta1.ParserFormatRegex,
+								Regex:  `^(?P<level>\w+`,
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid parser regex - no named capture group",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Input: telemetryv1beta1.LogPipelineInput{
+						Runtime: &telemetryv1beta1.LogPipelineRuntimeInput{
+							Parser: &telemetryv1beta1.LogPipelineParser{
+								Format: telemetryv1beta1.ParserFormatRegex,
+								Regex:  `^(\w+) (.*)$`,
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid deduplicate interval",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Deduplicate: &telemetryv1beta1.LogPipelineDeduplicate{Interval: &metav1.Duration{Duration: time.Second}},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "deduplicate without interval",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Deduplicate: &telemetryv1beta1.LogPipelineDeduplicate{},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid deduplicate interval - shorter than 1s",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Deduplicate: &telemetryv1beta1.LogPipelineDeduplicate{Interval: &metav1.Duration{Duration: 500 * time.Millisecond}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid deduplicate interval - zero",
+			pipeline: &telemetryv1beta1.LogPipeline{
+				Spec: telemetryv1beta1.LogPipelineSpec{
+					Deduplicate: &telemetryv1beta1.LogPipelineDeduplicate{Interval: &metav1.Duration{}},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "empty fields - should pass",
 			pipeline: &telemetryv1beta1.LogPipeline{
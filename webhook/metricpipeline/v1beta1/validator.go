@@ -2,17 +2,26 @@ package v1beta1
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
 
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
 	"github.com/kyma-project/telemetry-manager/internal/conditions"
 	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	metricpipelineutils "github.com/kyma-project/telemetry-manager/internal/utils/metricpipeline"
 	"github.com/kyma-project/telemetry-manager/internal/validators/runtimemetrics"
 	webhookutils "github.com/kyma-project/telemetry-manager/webhook/utils"
 )
 
+var (
+	errPrometheusOutputWithAgentInput = errors.New("prometheus output only supports the otlp input, the runtime, prometheus, and istio inputs must be disabled")
+	errPrometheusOutputExpiration     = errors.New("prometheus output expiration must be greater than 0")
+	errPrometheusOutputStaleness      = errors.New("prometheus output staleness must be greater than 0")
+)
+
 type validator struct {
 }
 
@@ -35,6 +44,22 @@ func validate(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) (a
 		return nil, err
 	}
 
+	if err := webhookutils.ValidateKafkaOutput(pipelines.SignalTypeMetric, pipeline.Spec.Output.Kafka); err != nil {
+		return nil, err
+	}
+
+	if err := validatePrometheusOutput(pipeline); err != nil {
+		return nil, err
+	}
+
+	if err := validateHostInput(pipeline.Spec.Input.Host); err != nil {
+		return nil, err
+	}
+
+	if err := validateAggregations(pipeline.Spec.Aggregations); err != nil {
+		return nil, err
+	}
+
 	runtimeAdditionalMetricsValidator := &runtimemetrics.Validator{}
 	if err := runtimeAdditionalMetricsValidator.Validate(pipeline); err != nil {
 		return nil, err
@@ -43,6 +68,63 @@ func validate(ctx context.Context, pipeline *telemetryv1beta1.MetricPipeline) (a
 	return nil, nil
 }
 
+// validatePrometheusOutput rejects the inputs collected by the metric agent for pipelines with a Prometheus output.
+// The metric agent sends data directly to the backend, so it never reaches the Prometheus endpoint of the OTLP Gateway.
+// Expiration and staleness must be positive, otherwise the exporter configuration of the OTLP Gateway is invalid.
+func validatePrometheusOutput(pipeline *telemetryv1beta1.MetricPipeline) error {
+	output := pipeline.Spec.Output.Prometheus
+	if output == nil {
+		return nil
+	}
+
+	input := pipeline.Spec.Input
+	if metricpipelineutils.IsRuntimeInputEnabled(input) || metricpipelineutils.IsPrometheusInputEnabled(input) || metricpipelineutils.IsIstioInputEnabled(input) {
+		return errPrometheusOutputWithAgentInput
+	}
+
+	if output.Expiration != nil && output.Expiration.Duration <= 0 {
+		return errPrometheusOutputExpiration
+	}
+
+	if output.Staleness != nil && output.Staleness.Duration <= 0 {
+		return errPrometheusOutputStaleness
+	}
+
+	return nil
+}
+
+// validateHostInput rejects mount point exclusions that are no valid regular expressions,
+// otherwise the filter processor of the metric agent fails to start.
+func validateHostInput(host *telemetryv1beta1.MetricPipelineHostInput) error {
+	if host == nil {
+		return nil
+	}
+
+	for _, mountPoint := range host.ExcludeMountPoints {
+		if _, err := regexp.Compile(mountPoint); err != nil {
+			return fmt.Errorf("invalid excludeMountPoints regex '%s': %w", mountPoint, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAggregations rejects metric name patterns that are no valid regular expressions,
+// otherwise the transform processor aggregating the metrics fails to start.
+func validateAggregations(aggregations []telemetryv1beta1.MetricAggregation) error {
+	for _, aggregation := range aggregations {
+		if aggregation.MetricNamePattern == "" {
+			continue
+		}
+
+		if _, err := regexp.Compile(aggregation.MetricNamePattern); err != nil {
+			return fmt.Errorf("invalid metricNamePattern regex '%s': %w", aggregation.MetricNamePattern, err)
+		}
+	}
+
+	return nil
+}
+
 func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.FilterSpec, transformSpec []telemetryv1beta1.TransformSpec) error {
 	err := webhookutils.ValidateFilterTransform(ctx, pipelines.SignalTypeMetric, filterSpec, transformSpec)
 	if err != nil {
@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -68,6 +69,106 @@ func TestMetricPipelineValidator_ValidateCreate_ValidateUpdate(t *testing.T) {
 				Build(),
 			expectErr: true,
 		},
+		{
+			name: "valid host input exclude mount points",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithHostInput(true).
+				WithHostInputExcludeMountPoints("^/boot$", "^/var/lib/.*").
+				Build(),
+			expectErr: false,
+		},
+		{
+			name: "invalid host input exclude mount points",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithHostInput(true).
+				WithHostInputExcludeMountPoints("^/boot$", "^/var/(lib").
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "valid aggregation metric name pattern",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithAggregation(telemetryv1beta1.MetricAggregation{
+					MetricNamePattern: "^queue_.+_size$",
+					KeepLabels:        []string{"queue"},
+				}).
+				Build(),
+			expectErr: false,
+		},
+		{
+			name: "invalid aggregation metric name pattern",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithAggregation(telemetryv1beta1.MetricAggregation{
+					MetricNamePattern: "^queue_(.+_size$",
+					KeepLabels:        []string{"queue"},
+				}).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "prometheus output with otlp input",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithOTLPInput(true).
+				WithPrometheusOutput().
+				Build(),
+			expectErr: false,
+		},
+		{
+			name: "prometheus output with runtime input",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithRuntimeInput(true).
+				WithPrometheusOutput().
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "prometheus output with prometheus input",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithPrometheusInput(true).
+				WithPrometheusOutput().
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "prometheus output with expiration and staleness",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithOTLPInput(true).
+				WithPrometheusOutput(testutils.PrometheusExpiration(10*time.Minute), testutils.PrometheusStaleness(time.Minute)).
+				Build(),
+			expectErr: false,
+		},
+		{
+			name: "prometheus output with zero expiration",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithOTLPInput(true).
+				WithPrometheusOutput(testutils.PrometheusExpiration(0)).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "prometheus output with negative expiration",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithOTLPInput(true).
+				WithPrometheusOutput(testutils.PrometheusExpiration(-time.Minute)).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "prometheus output with zero staleness",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithOTLPInput(true).
+				WithPrometheusOutput(testutils.PrometheusStaleness(0)).
+				Build(),
+			expectErr: true,
+		},
+		{
+			name: "prometheus output with negative staleness",
+			pipeline: testutils.NewMetricPipelineBuilder().
+				WithOTLPInput(true).
+				WithPrometheusOutput(testutils.PrometheusStaleness(-time.Minute)).
+				Build(),
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
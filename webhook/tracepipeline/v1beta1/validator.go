@@ -30,7 +30,11 @@ func (v *validator) ValidateDelete(_ context.Context, pipeline *telemetryv1beta1
 }
 
 func validate(ctx context.Context, obj *telemetryv1beta1.TracePipeline) (admission.Warnings, error) {
-	return nil, validateFilterTransform(ctx, obj.Spec.Filters, obj.Spec.Transforms)
+	if err := validateFilterTransform(ctx, obj.Spec.Filters, obj.Spec.Transforms); err != nil {
+		return nil, err
+	}
+
+	return nil, webhookutils.ValidateKafkaOutput(pipelines.SignalTypeTrace, obj.Spec.Output.Kafka)
 }
 
 func validateFilterTransform(ctx context.Context, filterSpec []telemetryv1beta1.FilterSpec, transformSpec []telemetryv1beta1.TransformSpec) error {
@@ -41,6 +41,21 @@ func TestTracePipelineValidator_ValidateCreate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "invalid kafka output - partitioned by resource attributes",
+			pipeline: &telemetryv1beta1.TracePipeline{
+				Spec: telemetryv1beta1.TracePipelineSpec{
+					Output: telemetryv1beta1.TracePipelineOutput{
+						Kafka: &telemetryv1beta1.KafkaOutput{
+							Brokers:     []string{"kafka:9092"},
+							Topic:       "traces",
+							PartitionBy: telemetryv1beta1.KafkaPartitionByResourceAttributes,
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "empty fields - should pass",
 			pipeline: &telemetryv1beta1.TracePipeline{
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+	"github.com/kyma-project/telemetry-manager/internal/validators/endpoint"
+)
+
+var errKafkaPlainSASLWithoutTLS = errors.New("kafka output with SASL mechanism 'PLAIN' requires TLS, otherwise the credentials are sent in plain text")
+
+// ValidateKafkaOutput checks that the Kafka output is consistent with the signal type of the pipeline it belongs to.
+// Traces can only be partitioned by trace ID, metrics only by resource attributes, logs by either of them.
+func ValidateKafkaOutput(signalType pipelines.SignalType, kafka *telemetryv1beta1.KafkaOutput) error {
+	if kafka == nil {
+		return nil
+	}
+
+	for _, broker := range kafka.Brokers {
+		if err := endpoint.ValidateKafkaBroker(broker); err != nil {
+			return fmt.Errorf("invalid kafka broker '%s': %w", broker, err)
+		}
+	}
+
+	switch {
+	case signalType == pipelines.SignalTypeTrace && kafka.PartitionBy == telemetryv1beta1.KafkaPartitionByResourceAttributes,
+		signalType == pipelines.SignalTypeMetric && kafka.PartitionBy == telemetryv1beta1.KafkaPartitionByTraceID:
+		return fmt.Errorf("kafka output of a %s pipeline cannot be partitioned by '%s'", signalType, kafka.PartitionBy)
+	}
+
+	tlsEnabled := kafka.TLS != nil && !kafka.TLS.Insecure
+	if kafka.Authentication != nil && kafka.Authentication.SASL != nil &&
+		kafka.Authentication.SASL.Mechanism == telemetryv1beta1.KafkaSASLMechanismPlain && !tlsEnabled {
+		return errKafkaPlainSASLWithoutTLS
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	telemetryv1beta1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1beta1"
+	"github.com/kyma-project/telemetry-manager/internal/pipelines"
+)
+
+func TestValidateKafkaOutput(t *testing.T) {
+	plainSASL := &telemetryv1beta1.KafkaAuthenticationOptions{
+		SASL: &telemetryv1beta1.KafkaSASLOptions{
+			Mechanism: telemetryv1beta1.KafkaSASLMechanismPlain,
+			User:      telemetryv1beta1.ValueType{Value: "user"},
+			Password:  telemetryv1beta1.ValueType{Value: "password"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		signalType pipelines.SignalType
+		kafka      *telemetryv1beta1.KafkaOutput
+		expectErr  bool
+	}{
+		{
+			name:       "no kafka output",
+			signalType: pipelines.SignalTypeTrace,
+		},
+		{
+			name:       "valid brokers",
+			signalType: pipelines.SignalTypeTrace,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers: []string{"kafka-0:9092", "kafka-1:9092"},
+				Topic:   "traces",
+			},
+		},
+		{
+			name:       "broker without port",
+			signalType: pipelines.SignalTypeTrace,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers: []string{"kafka-0:9092", "kafka-1"},
+				Topic:   "traces",
+			},
+			expectErr: true,
+		},
+		{
+			name:       "traces partitioned by trace ID",
+			signalType: pipelines.SignalTypeTrace,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers:     []string{"kafka:9092"},
+				Topic:       "traces",
+				PartitionBy: telemetryv1beta1.KafkaPartitionByTraceID,
+			},
+		},
+		{
+			name:       "traces partitioned by resource attributes",
+			signalType: pipelines.SignalTypeTrace,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers:     []string{"kafka:9092"},
+				Topic:       "traces",
+				PartitionBy: telemetryv1beta1.KafkaPartitionByResourceAttributes,
+			},
+			expectErr: true,
+		},
+		{
+			name:       "metrics partitioned by resource attributes",
+			signalType: pipelines.SignalTypeMetric,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers:     []string{"kafka:9092"},
+				Topic:       "metrics",
+				PartitionBy: telemetryv1beta1.KafkaPartitionByResourceAttributes,
+			},
+		},
+		{
+			name:       "metrics partitioned by trace ID",
+			signalType: pipelines.SignalTypeMetric,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers:     []string{"kafka:9092"},
+				Topic:       "metrics",
+				PartitionBy: telemetryv1beta1.KafkaPartitionByTraceID,
+			},
+			expectErr: true,
+		},
+		{
+			name:       "logs partitioned by trace ID",
+			signalType: pipelines.SignalTypeLog,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers:     []string{"kafka:9092"},
+				Topic:       "logs",
+				PartitionBy: telemetryv1beta1.KafkaPartitionByTraceID,
+			},
+		},
+		{
+			name:       "plain SASL with TLS",
+			signalType: pipelines.SignalTypeLog,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers:        []string{"kafka:9093"},
+				Topic:          "logs",
+				Authentication: plainSASL,
+				TLS:            &telemetryv1beta1.OutputTLS{},
+			},
+		},
+		{
+			name:       "plain SASL without TLS",
+			signalType: pipelines.SignalTypeLog,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers:        []string{"kafka:9092"},
+				Topic:          "logs",
+				Authentication: plainSASL,
+			},
+			expectErr: true,
+		},
+		{
+			name:       "plain SASL with insecure TLS",
+			signalType: pipelines.SignalTypeLog,
+			kafka: &telemetryv1beta1.KafkaOutput{
+				Brokers:        []string{"kafka:9092"},
+				Topic:          "logs",
+				Authentication: plainSASL,
+				TLS:            &telemetryv1beta1.OutputTLS{Insecure: true},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKafkaOutput(tt.signalType, tt.kafka)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}